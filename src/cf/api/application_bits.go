@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/net"
 	"encoding/json"
 	"fmt"
@@ -64,7 +65,7 @@ func (repo CloudControllerApplicationBitsRepository) uploadBits(app cf.Applicati
 
 	body, boundary, err := createApplicationUploadBody(zipBuffer, resourcesJson)
 	if err != nil {
-		apiResponse = net.NewApiResponseWithError("Error creating upload", err)
+		apiResponse = net.NewApiResponseWithError(i18n.T("application_bits.error_creating_upload"), err)
 		return
 	}
 
@@ -123,7 +124,7 @@ func (repo CloudControllerApplicationBitsRepository) uploadProgress(jobGuid stri
 	case uploadStatusFinished:
 		finished = true
 	case uploadStatusFailed:
-		apiResponse = net.NewApiResponseWithMessage("Failed to complete upload.")
+		apiResponse = net.NewApiResponseWithMessage("%s", i18n.T("application_bits.upload_failed"))
 	}
 
 	return
@@ -136,7 +137,7 @@ func (repo CloudControllerApplicationBitsRepository) createUploadDir(app cf.Appl
 	if fileIsZip(appDir) {
 		appDir, err = extractZip(app, appDir)
 		if err != nil {
-			apiResponse = net.NewApiResponseWithError("Error extracting archive", err)
+			apiResponse = net.NewApiResponseWithError(i18n.T("application_bits.error_extracting_archive"), err)
 			return
 		}
 	}
@@ -144,7 +145,7 @@ func (repo CloudControllerApplicationBitsRepository) createUploadDir(app cf.Appl
 	// Find which files need to be uploaded
 	allAppFiles, err := cf.AppFilesInDir(appDir)
 	if err != nil {
-		apiResponse = net.NewApiResponseWithError("Error listing app files", err)
+		apiResponse = net.NewApiResponseWithError(i18n.T("application_bits.error_listing_files"), err)
 		return
 	}
 
@@ -158,13 +159,13 @@ func (repo CloudControllerApplicationBitsRepository) createUploadDir(app cf.Appl
 
 	err = cf.InitializeDir(uploadDir)
 	if err != nil {
-		apiResponse = net.NewApiResponseWithError("Error creating upload directory", err)
+		apiResponse = net.NewApiResponseWithError(i18n.T("application_bits.error_creating_upload_directory"), err)
 		return
 	}
 
 	err = cf.CopyFiles(appFilesToUpload, appDir, uploadDir)
 	if err != nil {
-		apiResponse = net.NewApiResponseWithError("Error copying files to temp directory", err)
+		apiResponse = net.NewApiResponseWithError(i18n.T("application_bits.error_copying_files"), err)
 		return
 	}
 
@@ -242,7 +243,7 @@ func (repo CloudControllerApplicationBitsRepository) getFilesToUpload(allAppFile
 
 	resourcesJson, err := json.Marshal(appFilesRequest)
 	if err != nil {
-		apiResponse = net.NewApiResponseWithError("Failed to create json for resource_match request", err)
+		apiResponse = net.NewApiResponseWithError(i18n.T("application_bits.error_matching_resources"), err)
 		return
 	}
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/net"
 	"encoding/json"
 	"fmt"
@@ -131,7 +132,7 @@ func (repo CloudControllerApplicationRepository) SetEnv(app cf.Application, envV
 
 	jsonBytes, err := json.Marshal(body)
 	if err != nil {
-		apiResponse = net.NewApiResponseWithError("Error creating json", err)
+		apiResponse = net.NewApiResponseWithError(i18n.T("applications.error_creating_json"), err)
 		return
 	}
 
@@ -222,7 +223,7 @@ func (repo CloudControllerApplicationRepository) Scale(app cf.Application) (apiR
 
 	bodyBytes, err := json.Marshal(values)
 	if err != nil {
-		return net.NewApiResponseWithError("Error generating body", err)
+		return net.NewApiResponseWithError(i18n.T("applications.error_generating_body"), err)
 	}
 
 	request, apiResponse := repo.gateway.NewRequest("PUT", path, repo.config.AccessToken, bytes.NewReader(bodyBytes))
@@ -289,7 +290,7 @@ func (repo CloudControllerApplicationRepository) updateApplication(app cf.Applic
 
 	body, err := json.Marshal(updates)
 	if err != nil {
-		apiResponse = net.NewApiResponseWithError("Could not serialize app updates.", err)
+		apiResponse = net.NewApiResponseWithError(i18n.T("applications.error_serializing_updates"), err)
 		return
 	}
 
@@ -314,7 +315,7 @@ func (repo CloudControllerApplicationRepository) updateApplication(app cf.Applic
 func validateApplication(app cf.Application) (apiResponse net.ApiResponse) {
 	reg := regexp.MustCompile("^[0-9a-zA-Z\\-_]*$")
 	if !reg.MatchString(app.Name) {
-		apiResponse = net.NewApiResponseWithMessage("App name is invalid: name can only contain letters, numbers, underscores and hyphens")
+		apiResponse = net.NewApiResponseWithMessage("%s", i18n.T("applications.invalid_name"))
 	}
 
 	return
@@ -1,18 +1,23 @@
 package api
 
 import (
+	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/net"
-	"cf/terminal"
 	"encoding/base64"
 	"fmt"
 	"net/url"
-	"os"
 	"strings"
 )
 
+const defaultClientId = "cf"
+
 type AuthenticationRepository interface {
-	Authenticate(email string, password string) (apiResponse net.ApiResponse)
+	Authenticate(credentials map[string]string) (apiResponse net.ApiResponse)
+	AuthenticateClient(clientId string, clientSecret string) (apiResponse net.ApiResponse)
+	AuthenticateWithPasscode(passcode string) (apiResponse net.ApiResponse)
+	GetLoginPrompts() (prompts map[string]cf.AuthPrompt, apiResponse net.ApiResponse)
 	RefreshAuthToken() (updatedToken string, apiResponse net.ApiResponse)
 }
 
@@ -29,40 +34,146 @@ func NewUAAAuthenticationRepository(gateway net.Gateway, configRepo configuratio
 	return
 }
 
-func (uaa UAAAuthenticationRepository) Authenticate(email string, password string) (apiResponse net.ApiResponse) {
+func (uaa UAAAuthenticationRepository) Authenticate(credentials map[string]string) (apiResponse net.ApiResponse) {
+	data := url.Values{
+		"grant_type": {"password"},
+		"scope":      {""},
+	}
+	for key, value := range credentials {
+		data[key] = []string{value}
+	}
+
+	uaa.config.ClientId = ""
+	uaa.config.ClientSecret = ""
+
+	apiResponse = uaa.getAuthToken(data, defaultClientId, "")
+	if apiResponse.IsNotSuccessful() && apiResponse.StatusCode == 401 {
+		apiResponse.Message = i18n.T("authentication.password_incorrect")
+	}
+	return
+}
+
+func (uaa UAAAuthenticationRepository) AuthenticateClient(clientId string, clientSecret string) (apiResponse net.ApiResponse) {
+	data := url.Values{
+		"grant_type": {"client_credentials"},
+	}
+
+	uaa.config.ClientId = clientId
+	uaa.config.ClientSecret = clientSecret
+	uaa.config.RefreshToken = ""
+
+	apiResponse = uaa.getAuthToken(data, clientId, clientSecret)
+	if apiResponse.IsNotSuccessful() {
+		uaa.config.ClientId = ""
+		uaa.config.ClientSecret = ""
+
+		if apiResponse.StatusCode == 401 {
+			apiResponse.Message = i18n.T("authentication.client_credentials_incorrect")
+		}
+	}
+	return
+}
+
+func (uaa UAAAuthenticationRepository) AuthenticateWithPasscode(passcode string) (apiResponse net.ApiResponse) {
 	data := url.Values{
-		"username":   {email},
-		"password":   {password},
+		"passcode":   {passcode},
 		"grant_type": {"password"},
 		"scope":      {""},
 	}
 
-	apiResponse = uaa.getAuthToken(data)
+	uaa.config.ClientId = ""
+	uaa.config.ClientSecret = ""
+
+	apiResponse = uaa.getAuthToken(data, defaultClientId, "")
 	if apiResponse.IsNotSuccessful() && apiResponse.StatusCode == 401 {
-		apiResponse.Message = "Password is incorrect, please try again."
+		apiResponse.Message = i18n.T("authentication.passcode_incorrect")
+	}
+	return
+}
+
+func (uaa UAAAuthenticationRepository) GetLoginPrompts() (prompts map[string]cf.AuthPrompt, apiResponse net.ApiResponse) {
+	type LoginResponse struct {
+		Prompts map[string][]string `json:"prompts"`
+	}
+
+	path := fmt.Sprintf("%s/login", uaa.config.AuthorizationEndpoint)
+	request, apiResponse := uaa.gateway.NewRequest("GET", path, "", nil)
+	if apiResponse.IsNotSuccessful() {
+		return
+	}
+
+	response := new(LoginResponse)
+	_, apiResponse = uaa.gateway.PerformRequestForJSONResponse(request, &response)
+	if apiResponse.IsNotSuccessful() {
+		return
+	}
+
+	prompts = map[string]cf.AuthPrompt{}
+	for key, values := range response.Prompts {
+		if len(values) < 2 {
+			continue
+		}
+
+		promptType := cf.AuthPromptTypeText
+		if strings.ToUpper(values[0]) == string(cf.AuthPromptTypePassword) {
+			promptType = cf.AuthPromptTypePassword
+		}
+
+		prompts[key] = cf.AuthPrompt{
+			Type:        promptType,
+			DisplayName: values[1],
+		}
 	}
 	return
 }
 
 func (uaa UAAAuthenticationRepository) RefreshAuthToken() (updatedToken string, apiResponse net.ApiResponse) {
+	if uaa.config.IsClientLogin() {
+		return uaa.refetchClientToken()
+	}
+
+	if uaa.config.RefreshToken == "" {
+		apiResponse = net.NewSessionExpiredApiResponse("%s", i18n.T("authentication.session_expired"))
+		return
+	}
+
 	data := url.Values{
 		"refresh_token": {uaa.config.RefreshToken},
 		"grant_type":    {"refresh_token"},
 		"scope":         {""},
 	}
 
-	apiResponse = uaa.getAuthToken(data)
+	apiResponse = uaa.getAuthToken(data, defaultClientId, "")
+	if apiResponse.IsNotSuccessful() {
+		if apiResponse.StatusCode >= 400 && apiResponse.StatusCode < 500 {
+			apiResponse = net.NewSessionExpiredApiResponse("%s\n%s", i18n.T("authentication.session_expired"), apiResponse.Message)
+		}
+		return
+	}
+
 	updatedToken = uaa.config.AccessToken
+	return
+}
 
-	if apiResponse.IsError() {
-		fmt.Printf("%s\n\n", terminal.NotLoggedInText())
-		os.Exit(1)
+// Client credentials grants do not issue refresh tokens, so the token is fetched again instead.
+func (uaa UAAAuthenticationRepository) refetchClientToken() (updatedToken string, apiResponse net.ApiResponse) {
+	data := url.Values{
+		"grant_type": {"client_credentials"},
 	}
 
+	apiResponse = uaa.getAuthToken(data, uaa.config.ClientId, uaa.config.ClientSecret)
+	if apiResponse.IsNotSuccessful() {
+		if apiResponse.StatusCode >= 400 && apiResponse.StatusCode < 500 {
+			apiResponse = net.NewSessionExpiredApiResponse("%s\n%s", i18n.T("authentication.session_expired"), apiResponse.Message)
+		}
+		return
+	}
+
+	updatedToken = uaa.config.AccessToken
 	return
 }
 
-func (uaa UAAAuthenticationRepository) getAuthToken(data url.Values) (apiResponse net.ApiResponse) {
+func (uaa UAAAuthenticationRepository) getAuthToken(data url.Values, clientId, clientSecret string) (apiResponse net.ApiResponse) {
 	type uaaErrorResponse struct {
 		Code        string `json:"error"`
 		Description string `json:"error_description"`
@@ -76,7 +187,8 @@ func (uaa UAAAuthenticationRepository) getAuthToken(data url.Values) (apiRespons
 	}
 
 	path := fmt.Sprintf("%s/oauth/token", uaa.config.AuthorizationEndpoint)
-	request, apiResponse := uaa.gateway.NewRequest("POST", path, "Basic "+base64.StdEncoding.EncodeToString([]byte("cf:")), strings.NewReader(data.Encode()))
+	clientAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte(clientId+":"+clientSecret))
+	request, apiResponse := uaa.gateway.NewRequest("POST", path, clientAuth, strings.NewReader(data.Encode()))
 	if apiResponse.IsNotSuccessful() {
 		return
 	}
@@ -90,7 +202,7 @@ func (uaa UAAAuthenticationRepository) getAuthToken(data url.Values) (apiRespons
 	}
 
 	if response.Error.Code != "" {
-		apiResponse = net.NewApiResponseWithMessage("Authentication Server error: %s", response.Error.Description)
+		apiResponse = net.NewApiResponseWithMessage(i18n.T("authentication.server_error"), response.Error.Description)
 		return
 	}
 
@@ -98,7 +210,7 @@ func (uaa UAAAuthenticationRepository) getAuthToken(data url.Values) (apiRespons
 	uaa.config.RefreshToken = response.RefreshToken
 	err := uaa.configRepo.Save()
 	if err != nil {
-		apiResponse = net.NewApiResponseWithError("Error setting configuration", err)
+		apiResponse = net.NewApiResponseWithError(i18n.T("authentication.error_saving_config"), err)
 	}
 
 	return
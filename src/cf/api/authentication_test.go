@@ -1,6 +1,7 @@
 package api
 
 import (
+	"cf"
 	"cf/net"
 	"encoding/base64"
 	"fmt"
@@ -51,7 +52,7 @@ func TestSuccessfullyLoggingIn(t *testing.T) {
 	ts, auth := setupAuthWithEndpoint(t, successfulLoginEndpoint)
 	defer ts.Close()
 
-	apiResponse := auth.Authenticate("foo@example.com", "bar")
+	apiResponse := auth.Authenticate(map[string]string{"username": "foo@example.com", "password": "bar"})
 	savedConfig := testconfig.SavedConfiguration
 
 	assert.False(t, apiResponse.IsError())
@@ -68,7 +69,7 @@ func TestUnsuccessfullyLoggingIn(t *testing.T) {
 	ts, auth := setupAuthWithEndpoint(t, unsuccessfulLoginEndpoint)
 	defer ts.Close()
 
-	apiResponse := auth.Authenticate("foo@example.com", "oops wrong pass")
+	apiResponse := auth.Authenticate(map[string]string{"username": "foo@example.com", "password": "oops wrong pass"})
 	savedConfig := testconfig.SavedConfiguration
 
 	assert.True(t, apiResponse.IsNotSuccessful())
@@ -84,7 +85,7 @@ func TestServerErrorLoggingIn(t *testing.T) {
 	ts, auth := setupAuthWithEndpoint(t, errorLoginEndpoint)
 	defer ts.Close()
 
-	apiResponse := auth.Authenticate("foo@example.com", "bar")
+	apiResponse := auth.Authenticate(map[string]string{"username": "foo@example.com", "password": "bar"})
 	savedConfig := testconfig.SavedConfiguration
 
 	assert.True(t, apiResponse.IsError())
@@ -105,7 +106,7 @@ func TestLoggingInWithErrorMaskedAsSuccess(t *testing.T) {
 	ts, auth := setupAuthWithEndpoint(t, errorMaskedAsSuccessEndpoint)
 	defer ts.Close()
 
-	apiResponse := auth.Authenticate("foo@example.com", "bar")
+	apiResponse := auth.Authenticate(map[string]string{"username": "foo@example.com", "password": "bar"})
 	savedConfig := testconfig.SavedConfiguration
 
 	assert.True(t, apiResponse.IsError())
@@ -113,6 +114,214 @@ func TestLoggingInWithErrorMaskedAsSuccess(t *testing.T) {
 	assert.Empty(t, savedConfig.AccessToken)
 }
 
+func TestRefreshingTheAuthToken(t *testing.T) {
+	ts, auth := setupAuthWithEndpoint(t, func(writer http.ResponseWriter, request *http.Request) {
+		request.ParseForm()
+		if request.Form.Get("grant_type") != "refresh_token" || request.Form.Get("refresh_token") != "my_refresh_token" {
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintln(writer, `{"access_token":"my_new_access_token","token_type":"BEARER","refresh_token":"my_new_refresh_token"}`)
+	})
+	defer ts.Close()
+	auth.config.RefreshToken = "my_refresh_token"
+
+	updatedToken, apiResponse := auth.RefreshAuthToken()
+
+	assert.True(t, apiResponse.IsSuccessful())
+	assert.Equal(t, updatedToken, "BEARER my_new_access_token")
+	assert.Equal(t, testconfig.SavedConfiguration.RefreshToken, "my_new_refresh_token")
+}
+
+func TestRefreshingTheAuthTokenWhenTheSessionHasExpired(t *testing.T) {
+	ts, auth := setupAuthWithEndpoint(t, unsuccessfulLoginEndpoint)
+	defer ts.Close()
+	auth.config.RefreshToken = "my_expired_refresh_token"
+
+	updatedToken, apiResponse := auth.RefreshAuthToken()
+
+	assert.True(t, apiResponse.IsNotSuccessful())
+	assert.True(t, apiResponse.IsSessionExpired())
+	assert.Empty(t, updatedToken)
+}
+
+func TestRefreshingTheAuthTokenWithoutARefreshToken(t *testing.T) {
+	ts, auth := setupAuthWithEndpoint(t, successfulLoginEndpoint)
+	defer ts.Close()
+	auth.config.RefreshToken = ""
+
+	_, apiResponse := auth.RefreshAuthToken()
+
+	assert.True(t, apiResponse.IsSessionExpired())
+}
+
+func TestRefreshingTheAuthTokenWhenTheServerFails(t *testing.T) {
+	ts, auth := setupAuthWithEndpoint(t, errorLoginEndpoint)
+	defer ts.Close()
+	auth.config.RefreshToken = "my_refresh_token"
+
+	_, apiResponse := auth.RefreshAuthToken()
+
+	assert.True(t, apiResponse.IsNotSuccessful())
+	assert.False(t, apiResponse.IsSessionExpired())
+}
+
+var clientCredentialsEndpoint = func(writer http.ResponseWriter, request *http.Request) {
+	encodedAuth := base64.StdEncoding.EncodeToString([]byte("my-client:my-secret"))
+	request.ParseForm()
+
+	if request.Header.Get("authorization") != "Basic "+encodedAuth || request.Form.Get("grant_type") != "client_credentials" {
+		writer.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	fmt.Fprintln(writer, `{"access_token":"my_client_access_token","token_type":"BEARER","expires_in":43199}`)
+}
+
+func TestSuccessfullyAuthenticatingAClient(t *testing.T) {
+	ts, auth := setupAuthWithEndpoint(t, clientCredentialsEndpoint)
+	defer ts.Close()
+	auth.config.RefreshToken = "stale_refresh_token"
+
+	apiResponse := auth.AuthenticateClient("my-client", "my-secret")
+	savedConfig := testconfig.SavedConfiguration
+
+	assert.True(t, apiResponse.IsSuccessful())
+	assert.Equal(t, savedConfig.AccessToken, "BEARER my_client_access_token")
+	assert.Equal(t, savedConfig.RefreshToken, "")
+	assert.Equal(t, savedConfig.ClientId, "my-client")
+	assert.Equal(t, savedConfig.ClientSecret, "my-secret")
+}
+
+func TestUnsuccessfullyAuthenticatingAClient(t *testing.T) {
+	ts, auth := setupAuthWithEndpoint(t, clientCredentialsEndpoint)
+	defer ts.Close()
+
+	apiResponse := auth.AuthenticateClient("my-client", "wrong-secret")
+
+	assert.True(t, apiResponse.IsNotSuccessful())
+	assert.Equal(t, apiResponse.Message, "Client credentials are incorrect, please try again.")
+	assert.Empty(t, auth.config.ClientId)
+	assert.Empty(t, auth.config.AccessToken)
+}
+
+func TestRefreshingTheAuthTokenRefetchesClientTokens(t *testing.T) {
+	ts, auth := setupAuthWithEndpoint(t, clientCredentialsEndpoint)
+	defer ts.Close()
+	auth.config.ClientId = "my-client"
+	auth.config.ClientSecret = "my-secret"
+
+	updatedToken, apiResponse := auth.RefreshAuthToken()
+
+	assert.True(t, apiResponse.IsSuccessful())
+	assert.Equal(t, updatedToken, "BEARER my_client_access_token")
+}
+
+func TestAuthenticatingAUserForgetsTheClient(t *testing.T) {
+	ts, auth := setupAuthWithEndpoint(t, successfulLoginEndpoint)
+	defer ts.Close()
+	auth.config.ClientId = "my-client"
+	auth.config.ClientSecret = "my-secret"
+
+	apiResponse := auth.Authenticate(map[string]string{"username": "foo@example.com", "password": "bar"})
+	savedConfig := testconfig.SavedConfiguration
+
+	assert.True(t, apiResponse.IsSuccessful())
+	assert.Empty(t, savedConfig.ClientId)
+	assert.Empty(t, savedConfig.ClientSecret)
+}
+
+var multiFactorLoginEndpoint = func(writer http.ResponseWriter, request *http.Request) {
+	request.ParseForm()
+
+	formMatches := request.Form.Get("grant_type") == "password" &&
+		request.Form.Get("username") == "foo@example.com" &&
+		request.Form.Get("password") == "bar" &&
+		request.Form.Get("mfaCode") == "123456"
+
+	if !formMatches {
+		writer.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	fmt.Fprintln(writer, `{"access_token":"my_access_token","token_type":"BEARER","refresh_token":"my_refresh_token"}`)
+}
+
+func TestLoggingInSendsAllCredentials(t *testing.T) {
+	ts, auth := setupAuthWithEndpoint(t, multiFactorLoginEndpoint)
+	defer ts.Close()
+
+	apiResponse := auth.Authenticate(map[string]string{
+		"username": "foo@example.com",
+		"password": "bar",
+		"mfaCode":  "123456",
+	})
+
+	assert.True(t, apiResponse.IsSuccessful())
+	assert.Equal(t, testconfig.SavedConfiguration.AccessToken, "BEARER my_access_token")
+}
+
+var passcodeLoginEndpoint = func(writer http.ResponseWriter, request *http.Request) {
+	request.ParseForm()
+
+	if request.Form.Get("grant_type") != "password" || request.Form.Get("passcode") != "my-passcode" {
+		writer.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	fmt.Fprintln(writer, `{"access_token":"my_access_token","token_type":"BEARER","refresh_token":"my_refresh_token"}`)
+}
+
+func TestSuccessfullyLoggingInWithAPasscode(t *testing.T) {
+	ts, auth := setupAuthWithEndpoint(t, passcodeLoginEndpoint)
+	defer ts.Close()
+
+	apiResponse := auth.AuthenticateWithPasscode("my-passcode")
+	savedConfig := testconfig.SavedConfiguration
+
+	assert.True(t, apiResponse.IsSuccessful())
+	assert.Equal(t, savedConfig.AccessToken, "BEARER my_access_token")
+	assert.Equal(t, savedConfig.RefreshToken, "my_refresh_token")
+}
+
+func TestUnsuccessfullyLoggingInWithAPasscode(t *testing.T) {
+	ts, auth := setupAuthWithEndpoint(t, passcodeLoginEndpoint)
+	defer ts.Close()
+
+	apiResponse := auth.AuthenticateWithPasscode("expired-passcode")
+
+	assert.True(t, apiResponse.IsNotSuccessful())
+	assert.Equal(t, apiResponse.Message, "Passcode is incorrect or has expired, please try again.")
+}
+
+var loginPromptsEndpoint = func(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != "GET" || request.URL.Path != "/login" {
+		writer.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	fmt.Fprintln(writer, `
+{
+  "prompts": {
+    "username": ["text", "Email"],
+    "passcode": ["password", "One Time Code"]
+  }
+}`)
+}
+
+func TestGettingTheLoginPrompts(t *testing.T) {
+	ts, auth := setupAuthWithEndpoint(t, loginPromptsEndpoint)
+	defer ts.Close()
+
+	prompts, apiResponse := auth.GetLoginPrompts()
+
+	assert.True(t, apiResponse.IsSuccessful())
+	assert.Equal(t, prompts, map[string]cf.AuthPrompt{
+		"username": cf.AuthPrompt{Type: cf.AuthPromptTypeText, DisplayName: "Email"},
+		"passcode": cf.AuthPrompt{Type: cf.AuthPromptTypePassword, DisplayName: "One Time Code"},
+	})
+}
+
 func setupAuthWithEndpoint(t *testing.T, handler func(http.ResponseWriter, *http.Request)) (ts *httptest.Server, auth UAAAuthenticationRepository) {
 	ts = httptest.NewTLSServer(http.HandlerFunc(handler))
 
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"cf"
+	"cf/configuration"
+	"cf/net"
+)
+
+// Listing the apps and services of a space, the spaces of an org or the orgs
+// refreshes the names cached for shell completion, whichever command asked.
+// Failing to write the cache does not fail the listing.
+type CompletionCachingSpaceRepository struct {
+	SpaceRepository
+	config *configuration.Configuration
+	cache  configuration.CompletionCache
+}
+
+func NewCompletionCachingSpaceRepository(spaceRepo SpaceRepository, config *configuration.Configuration, cache configuration.CompletionCache) (repo CompletionCachingSpaceRepository) {
+	repo.SpaceRepository = spaceRepo
+	repo.config = config
+	repo.cache = cache
+	return
+}
+
+func (repo CompletionCachingSpaceRepository) FindAll() (spaces []cf.Space, apiResponse net.ApiResponse) {
+	spaces, apiResponse = repo.SpaceRepository.FindAll()
+	if apiResponse.IsNotSuccessful() {
+		return
+	}
+
+	names := []string{}
+	for _, space := range spaces {
+		names = append(names, space.Name)
+	}
+	saveCompletionNames(repo.cache, repo.config, configuration.CompletionSpaces, names)
+	return
+}
+
+func (repo CompletionCachingSpaceRepository) GetSummary() (space cf.Space, apiResponse net.ApiResponse) {
+	space, apiResponse = repo.SpaceRepository.GetSummary()
+	if apiResponse.IsNotSuccessful() {
+		return
+	}
+
+	appNames := []string{}
+	for _, app := range space.Applications {
+		appNames = append(appNames, app.Name)
+	}
+	serviceNames := []string{}
+	for _, instance := range space.ServiceInstances {
+		serviceNames = append(serviceNames, instance.Name)
+	}
+	saveCompletionNames(repo.cache, repo.config, configuration.CompletionApps, appNames)
+	saveCompletionNames(repo.cache, repo.config, configuration.CompletionServices, serviceNames)
+	return
+}
+
+type CompletionCachingOrganizationRepository struct {
+	OrganizationRepository
+	config *configuration.Configuration
+	cache  configuration.CompletionCache
+}
+
+func NewCompletionCachingOrganizationRepository(orgRepo OrganizationRepository, config *configuration.Configuration, cache configuration.CompletionCache) (repo CompletionCachingOrganizationRepository) {
+	repo.OrganizationRepository = orgRepo
+	repo.config = config
+	repo.cache = cache
+	return
+}
+
+func (repo CompletionCachingOrganizationRepository) FindAll() (orgs []cf.Organization, apiResponse net.ApiResponse) {
+	orgs, apiResponse = repo.OrganizationRepository.FindAll()
+	if apiResponse.IsNotSuccessful() {
+		return
+	}
+
+	names := []string{}
+	for _, org := range orgs {
+		names = append(names, org.Name)
+	}
+	saveCompletionNames(repo.cache, repo.config, configuration.CompletionOrgs, names)
+	return
+}
+
+func saveCompletionNames(cache configuration.CompletionCache, config *configuration.Configuration, kind string, names []string) {
+	cache.Save(kind, configuration.CompletionScope(config, kind), names)
+}
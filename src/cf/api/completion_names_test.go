@@ -0,0 +1,47 @@
+package api
+
+import (
+	"cf"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	testapi "testhelpers/api"
+	testconfig "testhelpers/configuration"
+	"testing"
+)
+
+func TestSpaceSummariesRefreshTheCompletionCache(t *testing.T) {
+	config := &configuration.Configuration{Space: cf.Space{Name: "my-space", Guid: "my-space-guid"}}
+	cache := &testconfig.FakeCompletionCache{}
+	spaceRepo := &testapi.FakeSpaceRepository{SummarySpace: cf.Space{
+		Applications:     []cf.Application{{Name: "app-1"}, {Name: "app-2"}},
+		ServiceInstances: []cf.ServiceInstance{{Name: "my-service"}},
+	}}
+
+	repo := NewCompletionCachingSpaceRepository(spaceRepo, config, cache)
+	space, apiResponse := repo.GetSummary()
+
+	assert.True(t, apiResponse.IsSuccessful())
+	assert.Equal(t, len(space.Applications), 2)
+	assert.Equal(t, cache.NamesByKind["apps"], []string{"app-1", "app-2"})
+	assert.Equal(t, cache.ScopesByKind["apps"], "my-space-guid")
+	assert.Equal(t, cache.NamesByKind["services"], []string{"my-service"})
+	assert.Equal(t, cache.ScopesByKind["services"], "my-space-guid")
+}
+
+func TestListingSpacesAndOrgsRefreshesTheCompletionCache(t *testing.T) {
+	config := &configuration.Configuration{Target: "https://api.example.com", Organization: cf.Organization{Name: "my-org", Guid: "my-org-guid"}}
+	cache := &testconfig.FakeCompletionCache{}
+
+	spaceRepo := NewCompletionCachingSpaceRepository(&testapi.FakeSpaceRepository{Spaces: []cf.Space{{Name: "space-1"}}}, config, cache)
+	_, apiResponse := spaceRepo.FindAll()
+	assert.True(t, apiResponse.IsSuccessful())
+
+	orgRepo := NewCompletionCachingOrganizationRepository(&testapi.FakeOrgRepository{Organizations: []cf.Organization{{Name: "org-1"}, {Name: "org-2"}}}, config, cache)
+	_, apiResponse = orgRepo.FindAll()
+	assert.True(t, apiResponse.IsSuccessful())
+
+	assert.Equal(t, cache.NamesByKind["spaces"], []string{"space-1"})
+	assert.Equal(t, cache.ScopesByKind["spaces"], "my-org-guid")
+	assert.Equal(t, cache.NamesByKind["orgs"], []string{"org-1", "org-2"})
+	assert.Equal(t, cache.ScopesByKind["orgs"], configuration.CompletionScope(config, "orgs"))
+}
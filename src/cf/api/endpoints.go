@@ -3,6 +3,7 @@ package api
 import (
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/net"
 	"regexp"
 	"strings"
@@ -41,13 +42,15 @@ func (repo RemoteEndpointRepository) UpdateEndpoint(endpoint string) (apiRespons
 
 	scheme := request.URL.Scheme
 	if scheme != "http" && scheme != "https" {
-		apiResponse = net.NewApiResponseWithMessage("API endpoints should start with https:// or http://")
+		apiResponse = net.NewApiResponseWithMessage("%s", i18n.T("endpoints.invalid_scheme"))
 		return
 	}
 
 	type infoResponse struct {
 		ApiVersion            string `json:"api_version"`
 		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		LoggingEndpoint       string `json:"logging_endpoint"`
 	}
 
 	serverResponse := new(infoResponse)
@@ -60,10 +63,12 @@ func (repo RemoteEndpointRepository) UpdateEndpoint(endpoint string) (apiRespons
 	repo.config.Target = endpoint
 	repo.config.ApiVersion = serverResponse.ApiVersion
 	repo.config.AuthorizationEndpoint = serverResponse.AuthorizationEndpoint
+	repo.config.TokenEndpoint = serverResponse.TokenEndpoint
+	repo.config.LoggingEndpoint = serverResponse.LoggingEndpoint
 
 	err := repo.configRepo.Save()
 	if err != nil {
-		apiResponse = net.NewApiResponseWithMessage(err.Error())
+		apiResponse = net.NewApiResponseWithMessage("%s", err.Error())
 	}
 
 	return
@@ -86,7 +91,7 @@ func (repo RemoteEndpointRepository) GetEndpoint(name cf.EndpointType) (endpoint
 
 func (repo RemoteEndpointRepository) cloudControllerEndpoint() (endpoint string, apiResponse net.ApiResponse) {
 	if repo.config.Target == "" {
-		apiResponse = net.NewApiResponseWithMessage("Endpoint missing from config file")
+		apiResponse = net.NewApiResponseWithMessage("%s", i18n.T("endpoints.missing_from_config"))
 		return
 	}
 
@@ -94,9 +99,16 @@ func (repo RemoteEndpointRepository) cloudControllerEndpoint() (endpoint string,
 	return
 }
 
+// Older clouds do not advertise their endpoints in /v2/info, so they are
+// guessed from the login and API hosts instead.
 func (repo RemoteEndpointRepository) uaaControllerEndpoint() (endpoint string, apiResponse net.ApiResponse) {
+	if repo.config.TokenEndpoint != "" {
+		endpoint = repo.config.TokenEndpoint
+		return
+	}
+
 	if repo.config.AuthorizationEndpoint == "" {
-		apiResponse = net.NewApiResponseWithMessage("Endpoint missing from config file")
+		apiResponse = net.NewApiResponseWithMessage("%s", i18n.T("endpoints.missing_from_config"))
 		return
 	}
 
@@ -106,8 +118,13 @@ func (repo RemoteEndpointRepository) uaaControllerEndpoint() (endpoint string, a
 }
 
 func (repo RemoteEndpointRepository) loggregatorEndpoint() (endpoint string, apiResponse net.ApiResponse) {
+	if repo.config.LoggingEndpoint != "" {
+		endpoint = repo.config.LoggingEndpoint
+		return
+	}
+
 	if repo.config.Target == "" {
-		apiResponse = net.NewApiResponseWithMessage("Endpoint missing from config file")
+		apiResponse = net.NewApiResponseWithMessage("%s", i18n.T("endpoints.missing_from_config"))
 		return
 	}
 
@@ -163,6 +163,96 @@ func TestGetEndpointForLoggregatorInsecure(t *testing.T) {
 	assert.Equal(t, endpoint, "ws://loggregator.run.pivotal.io:4443")
 }
 
+var apiInfoEndpointWithDiscoveryFields = func(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/v2/info" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	infoResponse := `
+{
+  "authorization_endpoint": "https://login.example.com",
+  "token_endpoint": "https://auth.example.com",
+  "logging_endpoint": "wss://logs.example.com:443",
+  "api_version": "42.0.0"
+} `
+	fmt.Fprintln(w, infoResponse)
+}
+
+func TestUpdateEndpointSavesTheDiscoveredEndpoints(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ts, repo := createEndpointRepoForUpdate(configRepo, apiInfoEndpointWithDiscoveryFields)
+	defer ts.Close()
+
+	apiResponse := repo.UpdateEndpoint(ts.URL)
+	savedConfig := testconfig.SavedConfiguration
+
+	assert.True(t, apiResponse.IsSuccessful())
+	assert.Equal(t, savedConfig.AuthorizationEndpoint, "https://login.example.com")
+	assert.Equal(t, savedConfig.TokenEndpoint, "https://auth.example.com")
+	assert.Equal(t, savedConfig.LoggingEndpoint, "wss://logs.example.com:443")
+}
+
+func TestUpdateEndpointForgetsEndpointsThatAreNoLongerAdvertised(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+	config, _ := configRepo.Get()
+	config.TokenEndpoint = "https://auth.old.example.com"
+	config.LoggingEndpoint = "wss://logs.old.example.com:443"
+
+	ts, repo := createEndpointRepoForUpdate(configRepo, validApiInfoEndpoint)
+	defer ts.Close()
+
+	repo.UpdateEndpoint(ts.URL)
+	savedConfig := testconfig.SavedConfiguration
+
+	assert.Equal(t, savedConfig.TokenEndpoint, "")
+	assert.Equal(t, savedConfig.LoggingEndpoint, "")
+}
+
+func TestGetEndpointForUaa(t *testing.T) {
+	config := &configuration.Configuration{
+		AuthorizationEndpoint: "https://login.example.com",
+		TokenEndpoint:         "https://auth.example.com",
+	}
+
+	repo := createEndpointRepoForGet(config)
+
+	endpoint, apiResponse := repo.GetEndpoint(cf.UaaEndpointKey)
+
+	assert.True(t, apiResponse.IsSuccessful())
+	assert.Equal(t, endpoint, "https://auth.example.com")
+}
+
+func TestGetEndpointForUaaFallsBackToTheLoginHost(t *testing.T) {
+	config := &configuration.Configuration{
+		AuthorizationEndpoint: "https://login.example.com",
+	}
+
+	repo := createEndpointRepoForGet(config)
+
+	endpoint, apiResponse := repo.GetEndpoint(cf.UaaEndpointKey)
+
+	assert.True(t, apiResponse.IsSuccessful())
+	assert.Equal(t, endpoint, "https://uaa.example.com")
+}
+
+func TestGetEndpointForLoggregatorFromInfo(t *testing.T) {
+	config := &configuration.Configuration{
+		Target:          "https://api.custom-domain.com",
+		LoggingEndpoint: "wss://doppler.custom-domain.com:443",
+	}
+
+	repo := createEndpointRepoForGet(config)
+
+	endpoint, apiResponse := repo.GetEndpoint(cf.LoggregatorEndpointKey)
+
+	assert.True(t, apiResponse.IsSuccessful())
+	assert.Equal(t, endpoint, "wss://doppler.custom-domain.com:443")
+}
+
 func createEndpointRepoForGet(config *configuration.Configuration) (repo EndpointRepository) {
 	configRepo := testconfig.FakeConfigRepository{}
 	repo = NewEndpointRepository(config, net.NewCloudControllerGateway(), configRepo)
@@ -3,6 +3,7 @@ package api
 import (
 	"cf"
 	"cf/configuration"
+	"cf/net"
 	"code.google.com/p/go.net/websocket"
 	"crypto/tls"
 	"errors"
@@ -58,7 +59,7 @@ func (repo LoggregatorLogsRepository) connectToWebsocket(location string, app cf
 	config.Header.Add("Authorization", repo.config.AccessToken)
 	config.TlsConfig = &tls.Config{InsecureSkipVerify: true}
 
-	ws, err := websocket.DialConfig(config)
+	ws, err := net.DialWebsocket(config)
 	if err != nil {
 		return
 	}
@@ -62,6 +62,7 @@ func TestRecentLogsFor(t *testing.T) {
 	// method under test
 	err = logsRepo.RecentLogsFor(app, onConnect, onMessage)
 	assert.NoError(t, err)
+	assert.True(t, connected)
 
 	assert.Equal(t, len(dumpedMessages), 1)
 	assert.Equal(t, dumpedMessages[0].GetShortSourceTypeName(), expectedMessage.GetShortSourceTypeName())
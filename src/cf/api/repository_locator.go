@@ -7,11 +7,12 @@ import (
 )
 
 type RepositoryLocator struct {
-	authRepo AuthenticationRepository
+	authRepo        AuthenticationRepository
+	completionCache configuration.CompletionCache
 
 	endpointRepo      RemoteEndpointRepository
-	organizationRepo  CloudControllerOrganizationRepository
-	spaceRepo         CloudControllerSpaceRepository
+	organizationRepo  CompletionCachingOrganizationRepository
+	spaceRepo         CompletionCachingSpaceRepository
 	appRepo           CloudControllerApplicationRepository
 	appBitsRepo       CloudControllerApplicationBitsRepository
 	appSummaryRepo    CloudControllerAppSummaryRepository
@@ -40,8 +41,12 @@ func NewRepositoryLocator(config *configuration.Configuration, configRepo config
 	uaaGateway.SetTokenRefresher(loc.authRepo)
 
 	loc.endpointRepo = NewEndpointRepository(config, cloudControllerGateway, configRepo)
-	loc.organizationRepo = NewCloudControllerOrganizationRepository(config, cloudControllerGateway)
-	loc.spaceRepo = NewCloudControllerSpaceRepository(config, cloudControllerGateway)
+	// Without a cache file names are listed every time they are completed
+	completionCacheFile, _ := configuration.CompletionCacheFile()
+	loc.completionCache = configuration.NewCompletionDiskCache(completionCacheFile)
+
+	loc.organizationRepo = NewCompletionCachingOrganizationRepository(NewCloudControllerOrganizationRepository(config, cloudControllerGateway), config, loc.completionCache)
+	loc.spaceRepo = NewCompletionCachingSpaceRepository(NewCloudControllerSpaceRepository(config, cloudControllerGateway), config, loc.completionCache)
 	loc.appRepo = NewCloudControllerApplicationRepository(config, cloudControllerGateway)
 	loc.appBitsRepo = NewCloudControllerApplicationBitsRepository(config, cloudControllerGateway, cf.ApplicationZipper{})
 	loc.appSummaryRepo = NewCloudControllerAppSummaryRepository(config, cloudControllerGateway, loc.appRepo)
@@ -64,6 +69,10 @@ func (locator RepositoryLocator) GetAuthenticationRepository() AuthenticationRep
 	return locator.authRepo
 }
 
+func (locator RepositoryLocator) GetCompletionCache() configuration.CompletionCache {
+	return locator.completionCache
+}
+
 func (locator RepositoryLocator) GetEndpointRepository() EndpointRepository {
 	return locator.endpointRepo
 }
@@ -3,6 +3,7 @@ package api
 import (
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/net"
 	"fmt"
 	"strings"
@@ -98,7 +99,7 @@ func (repo CloudControllerRouteRepository) FindByHost(host string) (route cf.Rou
 	}
 
 	if len(resources.Resources) == 0 {
-		apiResponse = net.NewApiResponseWithMessage("Route not found")
+		apiResponse = net.NewApiResponseWithMessage("%s", i18n.T("routes.route_not_found"))
 		return
 	}
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/net"
 	"encoding/json"
 	"fmt"
@@ -164,7 +165,7 @@ func (repo CloudControllerServiceRepository) CreateUserProvidedServiceInstance(n
 	reqBody := RequestBody{name, params, repo.config.Space.Guid}
 	jsonBytes, err := json.Marshal(reqBody)
 	if err != nil {
-		apiResponse = net.NewApiResponseWithError("Error parsing response", err)
+		apiResponse = net.NewApiResponseWithError(i18n.T("services.error_parsing_response"), err)
 		return
 	}
 
@@ -187,7 +188,7 @@ func (repo CloudControllerServiceRepository) UpdateUserProvidedServiceInstance(s
 	reqBody := RequestBody{params}
 	jsonBytes, err := json.Marshal(reqBody)
 	if err != nil {
-		apiResponse = net.NewApiResponseWithError("Error parsing response", err)
+		apiResponse = net.NewApiResponseWithError(i18n.T("services.error_parsing_response"), err)
 		return
 	}
 
@@ -288,7 +289,7 @@ func (repo CloudControllerServiceRepository) UnbindService(instance cf.ServiceIn
 
 func (repo CloudControllerServiceRepository) DeleteService(instance cf.ServiceInstance) (apiResponse net.ApiResponse) {
 	if len(instance.ServiceBindings) > 0 {
-		return net.NewApiResponseWithMessage("Cannot delete service instance, apps are still bound to it")
+		return net.NewApiResponseWithMessage("%s", i18n.T("services.apps_still_bound"))
 	}
 
 	path := fmt.Sprintf("%s/v2/service_instances/%s", repo.config.Target, instance.Guid)
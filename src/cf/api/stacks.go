@@ -3,6 +3,7 @@ package api
 import (
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/net"
 	"fmt"
 )
@@ -51,7 +52,7 @@ func (repo CloudControllerStackRepository) FindByName(name string) (stack cf.Sta
 	}
 
 	if len(resources.Resources) == 0 {
-		apiResponse = net.NewApiResponseWithMessage("Stack %s not found", name)
+		apiResponse = net.NewApiResponseWithMessage(i18n.T("stacks.stack_not_found"), name)
 		return
 	}
 
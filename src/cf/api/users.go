@@ -3,6 +3,7 @@ package api
 import (
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/net"
 	"fmt"
 	"net/url"
@@ -166,7 +167,7 @@ func (repo CloudControllerUserRepository) setOrUnsetOrgRole(verb string, user cf
 	rolePath, found := roleToPathMap[role]
 
 	if !found {
-		apiResponse = net.NewApiResponseWithMessage("Invalid Role %s", role)
+		apiResponse = net.NewApiResponseWithMessage(i18n.T("users.invalid_role"), role)
 		return
 	}
 
@@ -202,7 +203,7 @@ func (repo CloudControllerUserRepository) setOrUnsetSpaceRole(verb string, user
 	rolePath, found := roleToPathMap[role]
 
 	if !found {
-		apiResponse = net.NewApiResponseWithMessage("Invalid Role %s", role)
+		apiResponse = net.NewApiResponseWithMessage(i18n.T("users.invalid_role"), role)
 		return
 	}
 
@@ -0,0 +1,112 @@
+package app
+
+import (
+	"cf/configuration"
+	"github.com/codegangsta/cli"
+	"sort"
+	"strings"
+)
+
+// Expands a user-defined alias in the command line and adds the configured
+// default flags of the command, before the app runs it. Defaults come first so
+// that flags from the alias and then the command line override them.
+func ResolveAliases(cliApp *cli.App, config *configuration.Configuration, args []string) (resolved []string) {
+	index := commandIndex(cliApp, args)
+	if index < 0 {
+		return args
+	}
+
+	globalArgs := args[:index]
+	cmdArgs := args[index+1:]
+	cmdName := args[index]
+
+	if expansion, found := config.Aliases[cmdName]; found && cliApp.Command(cmdName) == nil && len(expansion) > 0 {
+		cmdName = expansion[0]
+		aliasGlobalArgs, aliasCmdArgs := splitGlobalFlags(cliApp, expansion[1:])
+		globalArgs = append(append([]string{}, globalArgs...), aliasGlobalArgs...)
+		cmdArgs = append(aliasCmdArgs, cmdArgs...)
+	}
+
+	resolved = append(resolved, globalArgs...)
+	resolved = append(resolved, cmdName)
+
+	if command := cliApp.Command(cmdName); command != nil {
+		defaults := config.Defaults[command.Name]
+
+		flags := []string{}
+		for flag := range defaults {
+			flags = append(flags, flag)
+		}
+		sort.Strings(flags)
+
+		for _, flag := range flags {
+			resolved = append(resolved, "-"+flag+"="+defaults[flag])
+		}
+	}
+
+	return append(resolved, cmdArgs...)
+}
+
+// The position of the command name, after any global flags.
+func commandIndex(cliApp *cli.App, args []string) int {
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			return i
+		}
+
+		if !strings.Contains(arg, "=") && globalFlagTakesValue(cliApp, strings.TrimLeft(arg, "-")) {
+			i++
+		}
+	}
+	return -1
+}
+
+// Global flags in an alias have to go before the command to be parsed.
+func splitGlobalFlags(cliApp *cli.App, args []string) (globalArgs, cmdArgs []string) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name := strings.SplitN(strings.TrimLeft(arg, "-"), "=", 2)[0]
+		if !strings.HasPrefix(arg, "-") || !isGlobalFlag(cliApp, name) {
+			cmdArgs = append(cmdArgs, arg)
+			continue
+		}
+
+		globalArgs = append(globalArgs, arg)
+		if !strings.Contains(arg, "=") && globalFlagTakesValue(cliApp, name) && i+1 < len(args) {
+			i++
+			globalArgs = append(globalArgs, args[i])
+		}
+	}
+	return
+}
+
+func isGlobalFlag(cliApp *cli.App, name string) bool {
+	_, found := globalFlag(cliApp, name)
+	return found
+}
+
+func globalFlagTakesValue(cliApp *cli.App, name string) bool {
+	takesValue, _ := globalFlag(cliApp, name)
+	return takesValue
+}
+
+func globalFlag(cliApp *cli.App, name string) (takesValue, found bool) {
+	for _, flag := range cliApp.Flags {
+		switch flag := flag.(type) {
+		case cli.BoolFlag:
+			if flag.Name == name {
+				return false, true
+			}
+		case cli.StringFlag:
+			if flag.Name == name {
+				return true, true
+			}
+		case cli.StringSliceFlag:
+			if flag.Name == name {
+				return true, true
+			}
+		}
+	}
+	return false, false
+}
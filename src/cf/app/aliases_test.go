@@ -0,0 +1,72 @@
+package app
+
+import (
+	"cf/commands"
+	"cf/configuration"
+	"github.com/codegangsta/cli"
+	"github.com/stretchr/testify/assert"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+	"testing"
+)
+
+func TestResolveAliasesExpandsAnAlias(t *testing.T) {
+	config := &configuration.Configuration{
+		Aliases: map[string][]string{"deploy": []string{"push", "--no-route"}},
+	}
+
+	args := ResolveAliases(newAliasTestApp(), config, []string{"cf", "deploy", "my-app"})
+	assert.Equal(t, args, []string{"cf", "push", "--no-route", "my-app"})
+}
+
+func TestResolveAliasesDoesNotShadowCommands(t *testing.T) {
+	config := &configuration.Configuration{
+		Aliases: map[string][]string{"push": []string{"apps"}},
+	}
+
+	args := ResolveAliases(newAliasTestApp(), config, []string{"cf", "push", "my-app"})
+	assert.Equal(t, args, []string{"cf", "push", "my-app"})
+}
+
+func TestResolveAliasesSkipsGlobalFlags(t *testing.T) {
+	config := &configuration.Configuration{
+		Aliases: map[string][]string{"ls": []string{"apps"}},
+	}
+
+	args := ResolveAliases(newAliasTestApp(), config, []string{"cf", "--output", "json", "--quiet", "ls"})
+	assert.Equal(t, args, []string{"cf", "--output", "json", "--quiet", "apps"})
+
+	args = ResolveAliases(newAliasTestApp(), config, []string{"cf", "--output=json", "ls"})
+	assert.Equal(t, args, []string{"cf", "--output=json", "apps"})
+
+	args = ResolveAliases(newAliasTestApp(), config, []string{"cf", "--quiet"})
+	assert.Equal(t, args, []string{"cf", "--quiet"})
+}
+
+func TestResolveAliasesAddsDefaultFlagsBeforeTheArguments(t *testing.T) {
+	config := &configuration.Configuration{
+		Aliases:  map[string][]string{"deploy": []string{"push", "-m", "1G"}},
+		Defaults: map[string]map[string]string{"push": map[string]string{"m": "512M", "i": "2"}},
+	}
+
+	args := ResolveAliases(newAliasTestApp(), config, []string{"cf", "p", "my-app"})
+	assert.Equal(t, args, []string{"cf", "p", "-i=2", "-m=512M", "my-app"})
+
+	args = ResolveAliases(newAliasTestApp(), config, []string{"cf", "deploy", "my-app"})
+	assert.Equal(t, args, []string{"cf", "push", "-i=2", "-m=512M", "-m", "1G", "my-app"})
+}
+
+func TestResolveAliasesMovesGlobalFlagsBeforeTheCommand(t *testing.T) {
+	config := &configuration.Configuration{
+		Aliases: map[string][]string{"ls": []string{"apps", "--wide", "--sort", "name"}},
+	}
+
+	args := ResolveAliases(newAliasTestApp(), config, []string{"cf", "--quiet", "ls"})
+	assert.Equal(t, args, []string{"cf", "--quiet", "--wide", "--sort", "name", "apps"})
+}
+
+func newAliasTestApp() *cli.App {
+	cmdRunner := commands.NewRunner(&FakeCmdFactory{}, &testreq.FakeReqFactory{}, &testterm.FakeUI{})
+	app, _ := NewApp(cmdRunner)
+	return app
+}
@@ -3,29 +3,46 @@ package app
 import (
 	"cf"
 	"cf/commands"
+	"cf/i18n"
 	"cf/terminal"
 	"fmt"
 	"github.com/codegangsta/cli"
+	"strings"
 )
 
 func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 	app = cli.NewApp()
 	app.Name = cf.Name
-	app.Usage = cf.Usage
+	app.Usage = i18n.T("app.usage")
 	app.Version = cf.Version
+	app.Flags = []cli.Flag{
+		cli.StringFlag{Name: "output", Value: "text", Usage: i18n.T("app.output_flag")},
+		cli.BoolFlag{Name: "quiet", Usage: i18n.T("app.quiet_flag")},
+		cli.BoolFlag{Name: "wide", Usage: i18n.T("app.wide_flag")},
+		cli.StringFlag{Name: "sort", Value: "", Usage: i18n.T("app.sort_flag")},
+		cli.StringSliceFlag{Name: "filter", Value: &cli.StringSlice{}, Usage: i18n.T("app.filter_flag")},
+		cli.StringFlag{Name: "columns", Value: "", Usage: i18n.T("app.columns_flag")},
+	}
+	app.Action = func(c *cli.Context) {
+		if len(c.Args()) == 0 {
+			cli.ShowAppHelp(c)
+			return
+		}
+		cmdRunner.FailUnknownCommand(c.Args()[0], commandNames(app))
+	}
 	app.Commands = []cli.Command{
 		{
 			Name:        "api",
-			Description: "Set or view target api url",
-			Usage:       fmt.Sprintf("%s api [URL]", cf.Name),
+			Description: i18n.T("app.api_description"),
+			Usage:       usage(i18n.T("app.api_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("api", c)
 			},
 		},
 		{
 			Name:        "app",
-			Description: "Display health and status for app",
-			Usage:       fmt.Sprintf("%s app APP", cf.Name),
+			Description: i18n.T("app.app_description"),
+			Usage:       usage(i18n.T("app.app_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("app", c)
 			},
@@ -33,26 +50,55 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "apps",
 			ShortName:   "a",
-			Description: "List all apps in the target space",
-			Usage:       fmt.Sprintf("%s apps", cf.Name),
+			Description: i18n.T("app.apps_description"),
+			Usage:       usage(i18n.T("app.apps_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("apps", c)
 			},
 		},
+		{
+			Name:        "auth",
+			Description: i18n.T("app.auth_description"),
+			Usage: usage(i18n.T("app.auth_usage")) +
+				terminal.WarningColor(i18n.T("app.password_warning")) +
+				usage(i18n.T("app.auth_examples")),
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "client-credentials", Usage: i18n.T("app.auth_client_credentials_flag")},
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("auth", c)
+			},
+		},
 		{
 			Name:        "bind-service",
 			ShortName:   "bs",
-			Description: "Bind a service instance to an app",
-			Usage:       fmt.Sprintf("%s bind-service APP SERVICE_INSTANCE", cf.Name),
+			Description: i18n.T("app.bind_service_description"),
+			Usage:       usage(i18n.T("app.bind_service_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("bind-service", c)
 			},
 		},
+		{
+			Name:        "completion",
+			Description: i18n.T("app.completion_description"),
+			Usage:       usage(i18n.T("app.completion_usage")),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("completion", c)
+			},
+		},
+		{
+			Name:        "config",
+			Description: i18n.T("app.config_description"),
+			Usage:       usage(i18n.T("app.config_usage")),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("config", c)
+			},
+		},
 		{
 			Name:        "create-org",
 			ShortName:   "co",
-			Description: "Create an org",
-			Usage:       fmt.Sprintf("%s create-org ORG", cf.Name),
+			Description: i18n.T("app.create_org_description"),
+			Usage:       usage(i18n.T("app.create_org_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-org", c)
 			},
@@ -60,44 +106,40 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "create-service",
 			ShortName:   "cs",
-			Description: "Create a service instance",
-			Usage: fmt.Sprintf("%s create-service SERVICE PLAN SERVICE_INSTANCE\n\n", cf.Name) +
-				"EXAMPLE:\n" +
-				fmt.Sprintf("   %s create-service cleardb spark clear-db-mine\n\n", cf.Name) +
-				"TIP:\n" +
-				"   Use 'cf create-user-provided-service' to make user-provided services available to cf apps",
+			Description: i18n.T("app.create_service_description"),
+			Usage:       usage(i18n.T("app.create_service_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-service", c)
 			},
 		},
 		{
 			Name:        "create-service-auth-token",
-			Description: "Create a service auth token",
-			Usage:       fmt.Sprintf("%s create-service-auth-token LABEL PROVIDER TOKEN", cf.Name),
+			Description: i18n.T("app.create_service_auth_token_description"),
+			Usage:       usage(i18n.T("app.create_service_auth_token_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-service-auth-token", c)
 			},
 		},
 		{
 			Name:        "create-service-broker",
-			Description: "Create a service broker",
-			Usage:       fmt.Sprintf("%s create-service-broker SERVICE_BROKER USERNAME PASSWORD URL", cf.Name),
+			Description: i18n.T("app.create_service_broker_description"),
+			Usage:       usage(i18n.T("app.create_service_broker_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-service-broker", c)
 			},
 		},
 		{
 			Name:        "create-space",
-			Description: "Create a space",
-			Usage:       fmt.Sprintf("%s create-space SPACE", cf.Name),
+			Description: i18n.T("app.create_space_description"),
+			Usage:       usage(i18n.T("app.create_space_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-space", c)
 			},
 		},
 		{
 			Name:        "create-user",
-			Description: "Create a new user",
-			Usage:       fmt.Sprintf("%s create-user USERNAME PASSWORD", cf.Name),
+			Description: i18n.T("app.create_user_description"),
+			Usage:       usage(i18n.T("app.create_user_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-user", c)
 			},
@@ -105,12 +147,8 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "create-user-provided-service",
 			ShortName:   "cups",
-			Description: "Make a user-provided service available to cf apps",
-			Usage: fmt.Sprintf("%s create-user-provided-service SERVICE_INSTANCE \"comma, separated, parameter, names\"\n", cf.Name) +
-				fmt.Sprintf("   %s create-user-provided-service SERVICE_INSTANCE '{\"name\":\"value\",\"name\":\"value\"}'\n\n", cf.Name) +
-				"EXAMPLE:\n" +
-				fmt.Sprintf("   %s create-user-provided-service oracle-db-mine \"host, port, dbname, username, password\"\n", cf.Name) +
-				fmt.Sprintf("   %s create-user-provided-service oracle-db-mine '{\"username\":\"admin\",\"password\":\"pa55woRD\"}'", cf.Name),
+			Description: i18n.T("app.create_user_provided_service_description"),
+			Usage:       usage(i18n.T("app.create_user_provided_service_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-user-provided-service", c)
 			},
@@ -118,10 +156,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "delete",
 			ShortName:   "d",
-			Description: "Delete an app",
-			Usage:       fmt.Sprintf("%s delete -f APP", cf.Name),
+			Description: i18n.T("app.delete_description"),
+			Usage:       usage(i18n.T("app.delete_usage")),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("app.force_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete", c)
@@ -129,10 +167,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "delete-domain",
-			Description: "Delete a domain",
-			Usage:       fmt.Sprintf("%s delete-domain DOMAIN", cf.Name),
+			Description: i18n.T("app.delete_domain_description"),
+			Usage:       usage(i18n.T("app.delete_domain_usage")),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("app.force_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-domain", c)
@@ -140,10 +178,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "delete-org",
-			Description: "Delete an org",
-			Usage:       fmt.Sprintf("%s delete-org ORG", cf.Name),
+			Description: i18n.T("app.delete_org_description"),
+			Usage:       usage(i18n.T("app.delete_org_usage")),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("app.force_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-org", c)
@@ -152,18 +190,18 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "delete-service",
 			ShortName:   "ds",
-			Description: "Delete a service instance",
-			Usage:       fmt.Sprintf("%s delete-service SERVICE", cf.Name),
+			Description: i18n.T("app.delete_service_description"),
+			Usage:       usage(i18n.T("app.delete_service_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-service", c)
 			},
 		},
 		{
 			Name:        "delete-service-auth-token",
-			Description: "Delete a service auth token",
-			Usage:       fmt.Sprintf("%s delete-service-auth-token LABEL PROVIDER", cf.Name),
+			Description: i18n.T("app.delete_service_auth_token_description"),
+			Usage:       usage(i18n.T("app.delete_service_auth_token_usage")),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("app.force_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-service-auth-token", c)
@@ -171,10 +209,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "delete-service-broker",
-			Description: "Delete a service broker",
-			Usage:       fmt.Sprintf("%s delete-service-broker SERVICE_BROKER", cf.Name),
+			Description: i18n.T("app.delete_service_broker_description"),
+			Usage:       usage(i18n.T("app.delete_service_broker_usage")),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("app.force_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-service-broker", c)
@@ -182,10 +220,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "delete-space",
-			Description: "Delete a space",
-			Usage:       fmt.Sprintf("%s delete-space SPACE", cf.Name),
+			Description: i18n.T("app.delete_space_description"),
+			Usage:       usage(i18n.T("app.delete_space_usage")),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("app.force_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-space", c)
@@ -193,10 +231,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "delete-user",
-			Description: "Delete a user",
-			Usage:       fmt.Sprintf("%s delete-user USERNAME", cf.Name),
+			Description: i18n.T("app.delete_user_description"),
+			Usage:       usage(i18n.T("app.delete_user_usage")),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("app.force_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-user", c)
@@ -204,8 +242,8 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "domains",
-			Description: "List domains in the target org",
-			Usage:       fmt.Sprintf("%s domains", cf.Name),
+			Description: i18n.T("app.domains_description"),
+			Usage:       usage(i18n.T("app.domains_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("domains", c)
 			},
@@ -213,16 +251,16 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "env",
 			ShortName:   "e",
-			Description: "Show all env variables for an app",
-			Usage:       fmt.Sprintf("%s env APP", cf.Name),
+			Description: i18n.T("app.env_description"),
+			Usage:       usage(i18n.T("app.env_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("env", c)
 			},
 		},
 		{
 			Name:        "events",
-			Description: "Show recent app events",
-			Usage:       fmt.Sprintf("%s events APP", cf.Name),
+			Description: i18n.T("app.events_description"),
+			Usage:       usage(i18n.T("app.events_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("events", c)
 			},
@@ -230,8 +268,8 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "files",
 			ShortName:   "f",
-			Description: "Print out a list of files in a directory or the contents of a specific file",
-			Usage:       fmt.Sprintf("%s files APP [PATH]", cf.Name),
+			Description: i18n.T("app.files_description"),
+			Usage:       usage(i18n.T("app.files_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("files", c)
 			},
@@ -239,14 +277,17 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "login",
 			ShortName:   "l",
-			Description: "Log user in",
-			Usage: fmt.Sprintf("%s login [USERNAME] [PASSWORD]\n\n", cf.Name) +
-				terminal.WarningColor("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\n") +
-				"EXAMPLE:\n" +
-				fmt.Sprintf("   %s login (omit username and password to login interactively -- %s will prompt for both)\n", cf.Name, cf.Name) +
-				fmt.Sprintf("   %s login name@example.com pa55woRD (specify username and password to login non-interactively)\n", cf.Name) +
-				fmt.Sprintf("   %s login name@example.com \"my password\" (use quotes for passwords with a space)\n", cf.Name) +
-				fmt.Sprintf("   %s login name@example.com \"\\\"password\\\"\" (escape quotes if used in password)", cf.Name),
+			Description: i18n.T("app.login_description"),
+			Usage: usage(i18n.T("app.login_usage")) +
+				terminal.WarningColor(i18n.T("app.password_warning")) +
+				usage(i18n.T("app.login_examples")),
+			Flags: []cli.Flag{
+				cli.StringFlag{Name: "u", Value: "", Usage: i18n.T("app.login_username_flag")},
+				cli.StringFlag{Name: "p", Value: "", Usage: i18n.T("app.login_password_flag")},
+				cli.StringFlag{Name: "o", Value: "", Usage: i18n.T("app.org_flag")},
+				cli.StringFlag{Name: "s", Value: "", Usage: i18n.T("app.space_flag")},
+				cli.BoolFlag{Name: "sso", Usage: i18n.T("app.login_sso_flag")},
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("login", c)
 			},
@@ -254,18 +295,18 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "logout",
 			ShortName:   "lo",
-			Description: "Log user out",
-			Usage:       fmt.Sprintf("%s logout", cf.Name),
+			Description: i18n.T("app.logout_description"),
+			Usage:       usage(i18n.T("app.logout_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("logout", c)
 			},
 		},
 		{
 			Name:        "logs",
-			Description: "Tail or show recent logs for an app",
-			Usage:       fmt.Sprintf("%s logs APP", cf.Name),
+			Description: i18n.T("app.logs_description"),
+			Usage:       usage(i18n.T("app.logs_usage")),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "recent", Usage: "dump recent logs instead of tailing"},
+				cli.BoolFlag{Name: "recent", Usage: i18n.T("app.logs_recent_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("logs", c)
@@ -274,35 +315,43 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "marketplace",
 			ShortName:   "m",
-			Description: "List available offerings in the marketplace",
-			Usage:       fmt.Sprintf("%s marketplace", cf.Name),
+			Description: i18n.T("app.marketplace_description"),
+			Usage:       usage(i18n.T("app.marketplace_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("marketplace", c)
 			},
 		},
 		{
 			Name:        "map-domain",
-			Description: "Map a domain to a space",
-			Usage:       fmt.Sprintf("%s map-domain SPACE DOMAIN", cf.Name),
+			Description: i18n.T("app.map_domain_description"),
+			Usage:       usage(i18n.T("app.map_domain_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("map-domain", c)
 			},
 		},
 		{
 			Name:        "map-route",
-			Description: "Add a url route to an app",
-			Usage:       fmt.Sprintf("%s map-route APP DOMAIN [-n HOSTNAME]", cf.Name),
+			Description: i18n.T("app.map_route_description"),
+			Usage:       usage(i18n.T("app.map_route_usage")),
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: "n", Value: "", Usage: "Hostname"},
+				cli.StringFlag{Name: "n", Value: "", Usage: i18n.T("app.hostname_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("map-route", c)
 			},
 		},
+		{
+			Name:        "oauth-token",
+			Description: i18n.T("app.oauth_token_description"),
+			Usage:       usage(i18n.T("app.oauth_token_usage")),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("oauth-token", c)
+			},
+		},
 		{
 			Name:        "org",
-			Description: "Show org info",
-			Usage:       fmt.Sprintf("%s org ORG", cf.Name),
+			Description: i18n.T("app.org_description"),
+			Usage:       usage(i18n.T("app.org_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("org", c)
 			},
@@ -310,8 +359,8 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "orgs",
 			ShortName:   "o",
-			Description: "List all orgs",
-			Usage:       fmt.Sprintf("%s orgs", cf.Name),
+			Description: i18n.T("app.orgs_description"),
+			Usage:       usage(i18n.T("app.orgs_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("orgs", c)
 			},
@@ -319,31 +368,37 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "passwd",
 			ShortName:   "pw",
-			Description: "Change user password",
-			Usage:       fmt.Sprintf("%s passwd", cf.Name),
+			Description: i18n.T("app.passwd_description"),
+			Usage:       usage(i18n.T("app.passwd_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("passwd", c)
 			},
 		},
+		{
+			Name:        "profile",
+			Description: i18n.T("app.profile_description"),
+			Usage:       usage(i18n.T("app.profile_usage")),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("profile", c)
+			},
+		},
 		{
 			Name:        "push",
 			ShortName:   "p",
-			Description: "Push a new app or sync changes to an existing app",
-			Usage: fmt.Sprintf("%s push APP [-d DOMAIN] [-n HOST] [-i NUM_INSTANCES]\n", cf.Name) +
-				"               [-m MEMORY] [-b URL] [--no-[re]start] [--no-route] [-p PATH]\n" +
-				"               [-s STACK] [-c COMMAND]",
+			Description: i18n.T("app.push_description"),
+			Usage:       usage(i18n.T("app.push_usage")),
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: "d", Value: "", Usage: "Domain (for example: example.com)"},
-				cli.StringFlag{Name: "n", Value: "", Usage: "Hostname (for example: my-subdomain)"},
-				cli.IntFlag{Name: "i", Value: 1, Usage: "Number of instances"},
-				cli.StringFlag{Name: "m", Value: "128", Usage: "Memory limit (for example: 256, 1G, 1024M)"},
-				cli.StringFlag{Name: "b", Value: "", Usage: "Custom buildpack URL (for example: https://github.com/heroku/heroku-buildpack-play.git)"},
-				cli.BoolFlag{Name: "no-start", Usage: "Do not start an app after pushing"},
-				cli.BoolFlag{Name: "no-restart", Usage: "Do not restart an app after pushing"},
-				cli.BoolFlag{Name: "no-route", Usage: "Do not map a route to this app"},
-				cli.StringFlag{Name: "p", Value: "", Usage: "Path of app directory or zip file"},
-				cli.StringFlag{Name: "s", Value: "", Usage: "Stack to use"},
-				cli.StringFlag{Name: "c", Value: "", Usage: "Startup command"},
+				cli.StringFlag{Name: "d", Value: "", Usage: i18n.T("app.push_domain_flag")},
+				cli.StringFlag{Name: "n", Value: "", Usage: i18n.T("app.push_hostname_flag")},
+				cli.IntFlag{Name: "i", Value: 1, Usage: i18n.T("app.push_instances_flag")},
+				cli.StringFlag{Name: "m", Value: "128", Usage: i18n.T("app.push_memory_flag")},
+				cli.StringFlag{Name: "b", Value: "", Usage: i18n.T("app.push_buildpack_flag")},
+				cli.BoolFlag{Name: "no-start", Usage: i18n.T("app.push_no_start_flag")},
+				cli.BoolFlag{Name: "no-restart", Usage: i18n.T("app.push_no_restart_flag")},
+				cli.BoolFlag{Name: "no-route", Usage: i18n.T("app.push_no_route_flag")},
+				cli.StringFlag{Name: "p", Value: "", Usage: i18n.T("app.push_path_flag")},
+				cli.StringFlag{Name: "s", Value: "", Usage: i18n.T("app.push_stack_flag")},
+				cli.StringFlag{Name: "c", Value: "", Usage: i18n.T("app.push_command_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("push", c)
@@ -351,58 +406,58 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "rename",
-			Description: "Rename an app",
-			Usage:       fmt.Sprintf("%s rename APP NEW_APP", cf.Name),
+			Description: i18n.T("app.rename_description"),
+			Usage:       usage(i18n.T("app.rename_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("rename", c)
 			},
 		},
 		{
 			Name:        "rename-org",
-			Description: "Rename an org",
-			Usage:       fmt.Sprintf("%s rename-org ORG NEW_ORG", cf.Name),
+			Description: i18n.T("app.rename_org_description"),
+			Usage:       usage(i18n.T("app.rename_org_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("rename-org", c)
 			},
 		},
 		{
 			Name:        "rename-service",
-			Description: "Rename a service instance",
-			Usage:       fmt.Sprintf("%s rename-service SERVICE_INSTANCE NEW_SERVICE_INSTANCE", cf.Name),
+			Description: i18n.T("app.rename_service_description"),
+			Usage:       usage(i18n.T("app.rename_service_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("rename-service", c)
 			},
 		},
 		{
 			Name:        "rename-service-broker",
-			Description: "Rename a service broker",
-			Usage:       fmt.Sprintf("%s rename-service-broker SERVICE_BROKER NEW_SERVICE_BROKER", cf.Name),
+			Description: i18n.T("app.rename_service_broker_description"),
+			Usage:       usage(i18n.T("app.rename_service_broker_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("rename-service-broker", c)
 			},
 		},
 		{
 			Name:        "rename-space",
-			Description: "Rename a space",
-			Usage:       fmt.Sprintf("%s rename-space SPACE NEW_SPACE", cf.Name),
+			Description: i18n.T("app.rename_space_description"),
+			Usage:       usage(i18n.T("app.rename_space_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("rename-space", c)
 			},
 		},
 		{
 			Name:        "reserve-domain",
-			Description: "Reserve a domain on an org for later use",
-			Usage:       fmt.Sprintf("%s reserve-domain ORG DOMAIN", cf.Name),
+			Description: i18n.T("app.reserve_domain_description"),
+			Usage:       usage(i18n.T("app.reserve_domain_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("reserve-domain", c)
 			},
 		},
 		{
 			Name:        "reserve-route",
-			Description: "Reserve a url route on a space for later use",
-			Usage:       fmt.Sprintf("%s reserve-route SPACE DOMAIN [-n HOSTNAME]", cf.Name),
+			Description: i18n.T("app.reserve_route_description"),
+			Usage:       usage(i18n.T("app.reserve_route_usage")),
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: "n", Value: "", Usage: "Hostname"},
+				cli.StringFlag{Name: "n", Value: "", Usage: i18n.T("app.hostname_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("reserve-route", c)
@@ -411,8 +466,8 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "restart",
 			ShortName:   "rs",
-			Description: "Restart an app",
-			Usage:       fmt.Sprintf("%s restart APP", cf.Name),
+			Description: i18n.T("app.restart_description"),
+			Usage:       usage(i18n.T("app.restart_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("restart", c)
 			},
@@ -420,20 +475,20 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "routes",
 			ShortName:   "r",
-			Description: "List all routes",
-			Usage:       fmt.Sprintf("%s routes", cf.Name),
+			Description: i18n.T("app.routes_description"),
+			Usage:       usage(i18n.T("app.routes_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("routes", c)
 			},
 		},
 		{
 			Name:        "scale",
-			Description: "Change the disk quota, instance count, and memory limit for an app",
-			Usage:       fmt.Sprintf("%s scale APP -d DISK -i INSTANCES -m MEMORY", cf.Name),
+			Description: i18n.T("app.scale_description"),
+			Usage:       usage(i18n.T("app.scale_usage")),
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: "d", Value: "", Usage: "disk quota"},
-				cli.IntFlag{Name: "i", Value: 0, Usage: "number of instances"},
-				cli.StringFlag{Name: "m", Value: "", Usage: "memory limit"},
+				cli.StringFlag{Name: "d", Value: "", Usage: i18n.T("app.scale_disk_flag")},
+				cli.IntFlag{Name: "i", Value: 0, Usage: i18n.T("app.scale_instances_flag")},
+				cli.StringFlag{Name: "m", Value: "", Usage: i18n.T("app.scale_memory_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("scale", c)
@@ -441,24 +496,24 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "service",
-			Description: "Show service instance info",
-			Usage:       fmt.Sprintf("%s service SERVICE_INSTANCE", cf.Name),
+			Description: i18n.T("app.service_description"),
+			Usage:       usage(i18n.T("app.service_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("service", c)
 			},
 		},
 		{
 			Name:        "service-auth-tokens",
-			Description: "List service auth tokens",
-			Usage:       fmt.Sprintf("%s service-auth-tokens", cf.Name),
+			Description: i18n.T("app.service_auth_tokens_description"),
+			Usage:       usage(i18n.T("app.service_auth_tokens_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("service-auth-tokens", c)
 			},
 		},
 		{
 			Name:        "service-brokers",
-			Description: "List service brokers",
-			Usage:       fmt.Sprintf("%s service-brokers", cf.Name),
+			Description: i18n.T("app.service_brokers_description"),
+			Usage:       usage(i18n.T("app.service_brokers_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("service-brokers", c)
 			},
@@ -466,8 +521,8 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "services",
 			ShortName:   "s",
-			Description: "List all services in the target space",
-			Usage:       fmt.Sprintf("%s services", cf.Name),
+			Description: i18n.T("app.services_description"),
+			Usage:       usage(i18n.T("app.services_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("services", c)
 			},
@@ -475,42 +530,32 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "set-env",
 			ShortName:   "se",
-			Description: "Set an env variable for an app",
-			Usage:       fmt.Sprintf("%s set-env APP NAME VALUE", cf.Name),
+			Description: i18n.T("app.set_env_description"),
+			Usage:       usage(i18n.T("app.set_env_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("set-env", c)
 			},
 		},
 		{
 			Name:        "set-org-role",
-			Description: "Assign an org role to a user",
-			Usage: fmt.Sprintf("%s set-org-role USERNAME ORG ROLE\n\n", cf.Name) +
-				"ROLES:\n" +
-				"   OrgManager - Invite and manage users, select and change plans, and set spending limits\n" +
-				"   BillingManager - Create and manage the billing account and payment info\n" +
-				"   OrgAuditor - View logs, reports, and settings on this org and all spaces\n",
+			Description: i18n.T("app.set_org_role_description"),
+			Usage:       usage(i18n.T("app.set_org_role_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("set-org-role", c)
 			},
 		},
 		{
 			Name:        "set-quota",
-			Description: "Define the quota for an org",
-			Usage: fmt.Sprintf("%s set-quota ORG QUOTA\n\n", cf.Name) +
-				"TIP:\n" +
-				"   Allowable quotas are 'free,' 'paid,' 'runaway,' and 'trial'",
+			Description: i18n.T("app.set_quota_description"),
+			Usage:       usage(i18n.T("app.set_quota_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("set-quota", c)
 			},
 		},
 		{
 			Name:        "set-space-role",
-			Description: "Assign a space role to a user",
-			Usage: fmt.Sprintf("%s set-space-role USERNAME ORG SPACE ROLE\n\n", cf.Name) +
-				"ROLES:\n" +
-				"   SpaceManager - Invite and manage users, and enable features for a given space\n" +
-				"   SpaceDeveloper - Create and manage apps and services, and see logs and reports\n" +
-				"   SpaceAuditor - View logs, reports, and settings on this space\n",
+			Description: i18n.T("app.set_space_role_description"),
+			Usage:       usage(i18n.T("app.set_space_role_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("set-space-role", c)
 			},
@@ -525,24 +570,24 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		//		},
 		{
 			Name:        "space",
-			Description: "Show target space's info",
-			Usage:       fmt.Sprintf("%s space", cf.Name),
+			Description: i18n.T("app.space_description"),
+			Usage:       usage(i18n.T("app.space_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("space", c)
 			},
 		},
 		{
 			Name:        "spaces",
-			Description: "List all spaces in an org",
-			Usage:       fmt.Sprintf("%s spaces", cf.Name),
+			Description: i18n.T("app.spaces_description"),
+			Usage:       usage(i18n.T("app.spaces_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("spaces", c)
 			},
 		},
 		{
 			Name:        "stacks",
-			Description: "List all stacks",
-			Usage:       fmt.Sprintf("%s stacks", cf.Name),
+			Description: i18n.T("app.stacks_description"),
+			Usage:       usage(i18n.T("app.stacks_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("stacks", c)
 			},
@@ -550,8 +595,8 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "start",
 			ShortName:   "st",
-			Description: "Start an app",
-			Usage:       fmt.Sprintf("%s start APP", cf.Name),
+			Description: i18n.T("app.start_description"),
+			Usage:       usage(i18n.T("app.start_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("start", c)
 			},
@@ -559,8 +604,8 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "stop",
 			ShortName:   "sp",
-			Description: "Stop an app",
-			Usage:       fmt.Sprintf("%s stop APP", cf.Name),
+			Description: i18n.T("app.stop_description"),
+			Usage:       usage(i18n.T("app.stop_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("stop", c)
 			},
@@ -568,11 +613,11 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "target",
 			ShortName:   "t",
-			Description: "Set or view the targeted org or space",
-			Usage:       fmt.Sprintf("%s target [-o ORG] [-s SPACE]", cf.Name),
+			Description: i18n.T("app.target_description"),
+			Usage:       usage(i18n.T("app.target_usage")),
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: "o", Value: "", Usage: "organization"},
-				cli.StringFlag{Name: "s", Value: "", Usage: "space"},
+				cli.StringFlag{Name: "o", Value: "", Usage: i18n.T("app.org_flag")},
+				cli.StringFlag{Name: "s", Value: "", Usage: i18n.T("app.space_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("target", c)
@@ -581,26 +626,26 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "unbind-service",
 			ShortName:   "us",
-			Description: "Unbind a service instance from an app",
-			Usage:       fmt.Sprintf("%s unbind-service APP SERVICE_INSTANCE", cf.Name),
+			Description: i18n.T("app.unbind_service_description"),
+			Usage:       usage(i18n.T("app.unbind_service_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("unbind-service", c)
 			},
 		},
 		{
 			Name:        "unmap-domain",
-			Description: "Unmap a domain from a space",
-			Usage:       fmt.Sprintf("%s unmap-domain SPACE DOMAIN", cf.Name),
+			Description: i18n.T("app.unmap_domain_description"),
+			Usage:       usage(i18n.T("app.unmap_domain_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("unmap-domain", c)
 			},
 		},
 		{
 			Name:        "unmap-route",
-			Description: "Remove a url route from an app",
-			Usage:       fmt.Sprintf("%s unmap-route APP DOMAIN [-n HOSTNAME]", cf.Name),
+			Description: i18n.T("app.unmap_route_description"),
+			Usage:       usage(i18n.T("app.unmap_route_usage")),
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: "n", Value: "", Usage: "Hostname"},
+				cli.StringFlag{Name: "n", Value: "", Usage: i18n.T("app.hostname_flag")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("unmap-route", c)
@@ -608,40 +653,40 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "unset-env",
-			Description: "Remove an env variable",
-			Usage:       fmt.Sprintf("%s unset-env APP NAME", cf.Name),
+			Description: i18n.T("app.unset_env_description"),
+			Usage:       usage(i18n.T("app.unset_env_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("unset-env", c)
 			},
 		},
 		{
 			Name:        "unset-org-role",
-			Description: "Remove an org role from a user",
-			Usage:       fmt.Sprintf("%s unset-org-role USERNAME ORG ROLE", cf.Name),
+			Description: i18n.T("app.unset_org_role_description"),
+			Usage:       usage(i18n.T("app.unset_org_role_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("unset-org-role", c)
 			},
 		},
 		{
 			Name:        "unset-space-role",
-			Description: "Remove a space role from a user",
-			Usage:       fmt.Sprintf("%s unset-space-role USERNAME ORG SPACE ROLE", cf.Name),
+			Description: i18n.T("app.unset_space_role_description"),
+			Usage:       usage(i18n.T("app.unset_space_role_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("unset-space-role", c)
 			},
 		},
 		{
 			Name:        "update-service-broker",
-			Description: "Update a service broker",
-			Usage:       fmt.Sprintf("%s update-service-broker SERVICE_BROKER USERNAME PASSWORD URL", cf.Name),
+			Description: i18n.T("app.update_service_broker_description"),
+			Usage:       usage(i18n.T("app.update_service_broker_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("update-service-broker", c)
 			},
 		},
 		{
 			Name:        "update-service-auth-token",
-			Description: "Update a service auth token",
-			Usage:       fmt.Sprintf("%s update-service-auth-token LABEL PROVIDER TOKEN", cf.Name),
+			Description: i18n.T("app.update_service_auth_token_description"),
+			Usage:       usage(i18n.T("app.update_service_auth_token_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("update-service-auth-token", c)
 			},
@@ -649,14 +694,38 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "update-user-provided-service",
 			ShortName:   "uups",
-			Description: "Update user-provided service name value pairs",
-			Usage: fmt.Sprintf("%s update-user-provided-service SERVICE_INSTANCE '{\"name\":\"value\",\"name\":\"value\"}'\n\n", cf.Name) +
-				"EXAMPLE:\n" +
-				fmt.Sprintf("   %s update-user-provided-service oracle-db-mine '{\"username\":\"admin\",\"password\":\"pa55woRD\"}'", cf.Name),
+			Description: i18n.T("app.update_user_provided_service_description"),
+			Usage:       usage(i18n.T("app.update_user_provided_service_usage")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("update-user-provided-service", c)
 			},
 		},
+		{
+			Name:        "whoami",
+			Description: i18n.T("app.whoami_description"),
+			Usage:       usage(i18n.T("app.whoami_usage")),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("whoami", c)
+			},
+		},
+	}
+	return
+}
+
+// Usage messages have a %s wherever the name of the app goes
+func usage(message string) string {
+	names := make([]interface{}, strings.Count(message, "%s"))
+	for i := range names {
+		names[i] = cf.Name
+	}
+	return fmt.Sprintf(message, names...)
+}
+
+// Only full names are suggested for unknown commands, as any short name is
+// a single typo away from most others.
+func commandNames(app *cli.App) (names []string) {
+	for _, command := range app.Commands {
+		names = append(names, command.Name)
 	}
 	return
 }
@@ -18,13 +18,13 @@ func TestRunningCommands(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Contains(t, stdout, "API endpoint")
 
-	stdout, _, err = runCommand(t, "app")
+	_, stderr, err := runCommand(t, "app")
 	assert.Error(t, err)
-	assert.Contains(t, stdout, "FAILED")
+	assert.Contains(t, stderr, "FAILED")
 
-	stdout, _, err = runCommand(t, "target", "foo", "bar")
+	_, stderr, err = runCommand(t, "target", "foo", "bar")
 	assert.Error(t, err)
-	assert.Contains(t, stdout, "FAILED")
+	assert.Contains(t, stderr, "FAILED")
 }
 
 func runCommand(t *testing.T, params ...string) (stdout, stderr string, err error) {
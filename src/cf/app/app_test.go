@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"strings"
 	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
 	"testing"
 )
 
@@ -18,8 +19,9 @@ func (cmd FakeCmd) GetRequirements(reqFactory requirements.Factory, c *cli.Conte
 	return
 }
 
-func (cmd FakeCmd) Run(c *cli.Context) {
+func (cmd FakeCmd) Run(c *cli.Context) (err error) {
 	cmd.factory.CmdCompleted = true
+	return
 }
 
 type FakeCmdFactory struct {
@@ -38,7 +40,10 @@ func TestCommands(t *testing.T) {
 		"api",
 		"app",
 		"apps",
+		"auth",
 		"bind-service",
+		"completion",
+		"config",
 		"create-org",
 		"create-service",
 		"create-service-auth-token",
@@ -62,9 +67,11 @@ func TestCommands(t *testing.T) {
 		"map-domain",
 		"map-route",
 		"marketplace",
+		"oauth-token",
 		"org",
 		"orgs",
 		"passwd",
+		"profile",
 		"push",
 		"rename",
 		"rename-org",
@@ -99,12 +106,13 @@ func TestCommands(t *testing.T) {
 		"unset-space-role",
 		"update-service-broker",
 		"update-user-provided-service",
+		"whoami",
 	}
 
 	for _, cmdName := range availableCmds {
 		cmdFactory := &FakeCmdFactory{}
 		reqFactory := &testreq.FakeReqFactory{}
-		cmdRunner := commands.NewRunner(cmdFactory, reqFactory)
+		cmdRunner := commands.NewRunner(cmdFactory, reqFactory, &testterm.FakeUI{})
 		app, _ := NewApp(cmdRunner)
 		app.Run([]string{"", cmdName})
 
@@ -116,9 +124,23 @@ func TestCommands(t *testing.T) {
 func TestUsageIncludesCommandName(t *testing.T) {
 	cmdFactory := &FakeCmdFactory{}
 	reqFactory := &testreq.FakeReqFactory{}
-	cmdRunner := commands.NewRunner(cmdFactory, reqFactory)
+	cmdRunner := commands.NewRunner(cmdFactory, reqFactory, &testterm.FakeUI{})
 	app, _ := NewApp(cmdRunner)
 	for _, cmd := range app.Commands {
 		assert.Contains(t, strings.Split(cmd.Usage, "\n")[0], cmd.Name)
 	}
 }
+
+func TestUnknownCommandsFailWithSuggestions(t *testing.T) {
+	cmdFactory := &FakeCmdFactory{}
+	reqFactory := &testreq.FakeReqFactory{}
+	ui := &testterm.FakeUI{}
+	cmdRunner := commands.NewRunner(cmdFactory, reqFactory, ui)
+	app, _ := NewApp(cmdRunner)
+	app.Run([]string{"", "strat", "my-app"})
+
+	assert.False(t, cmdFactory.CmdCompleted)
+	assert.Equal(t, cmdRunner.ExitCode(), commands.ExitCodeUsage)
+	assert.Contains(t, ui.Outputs[1], "'strat' is not a registered command")
+	assert.Contains(t, ui.Outputs[1], "Did you mean start?")
+}
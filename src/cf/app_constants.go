@@ -3,5 +3,4 @@ package cf
 const (
 	Name    = "cf"
 	Version = "1.0.0.rc1-SHA"
-	Usage   = "A command line tool to interact with Cloud Foundry"
 )
@@ -3,6 +3,7 @@ package commands
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -16,7 +17,7 @@ type Api struct {
 }
 
 type ApiEndpointSetter interface {
-	SetApiEndpoint(endpoint string)
+	SetApiEndpoint(endpoint string) (err error)
 }
 
 func NewApi(ui terminal.UI, config *configuration.Configuration, endpointRepo api.EndpointRepository) (cmd Api) {
@@ -30,39 +31,40 @@ func (cmd Api) GetRequirements(reqFactory requirements.Factory, c *cli.Context)
 	return
 }
 
-func (cmd Api) Run(c *cli.Context) {
+func (cmd Api) Run(c *cli.Context) (err error) {
 	if len(c.Args()) == 0 {
 		cmd.showApiEndpoint()
 		return
 	}
 
-	cmd.SetApiEndpoint(c.Args()[0])
+	return cmd.SetApiEndpoint(c.Args()[0])
 }
 
 func (cmd Api) showApiEndpoint() {
 	cmd.ui.Say(
-		"API endpoint: %s (API version: %s)",
+		i18n.T("api.api_endpoint"),
 		terminal.EntityNameColor(cmd.config.Target),
 		terminal.EntityNameColor(cmd.config.ApiVersion),
 	)
 }
 
-func (cmd Api) SetApiEndpoint(endpoint string) {
-	cmd.ui.Say("Setting api endpoint to %s...", terminal.EntityNameColor(endpoint))
+func (cmd Api) SetApiEndpoint(endpoint string) (err error) {
+	cmd.ui.Status(i18n.T("api.setting_api_endpoint"), terminal.EntityNameColor(endpoint))
 
 	apiResponse := cmd.endpointRepo.UpdateEndpoint(endpoint)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
 
 	if !strings.HasPrefix(endpoint, "https://") {
-		cmd.ui.Say(terminal.WarningColor("\nWarning: Insecure http API endpoint detected: secure https API endpoints are recommended\n"))
+		cmd.ui.Warn(i18n.T("api.warning_insecure_http"))
 	}
 
 	cmd.showApiEndpoint()
 
-	cmd.ui.Say(terminal.NotLoggedInText())
+	cmd.ui.Status(terminal.NotLoggedInText())
+	return
 }
@@ -2,6 +2,7 @@ package application
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -23,7 +24,7 @@ func NewDeleteApp(ui terminal.UI, appRepo api.ApplicationRepository) (cmd *Delet
 
 func (cmd *DeleteApp) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) == 0 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "delete")
 		return
 	}
@@ -31,13 +32,13 @@ func (cmd *DeleteApp) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 	return
 }
 
-func (cmd *DeleteApp) Run(c *cli.Context) {
+func (cmd *DeleteApp) Run(c *cli.Context) (err error) {
 	appName := c.Args()[0]
 	force := c.Bool("f")
 
 	if !force {
 		response := cmd.ui.Confirm(
-			"Really delete %s?%s",
+			i18n.T("delete_app.confirm"),
 			terminal.EntityNameColor(appName),
 			terminal.PromptColor(">"),
 		)
@@ -46,25 +47,25 @@ func (cmd *DeleteApp) Run(c *cli.Context) {
 		}
 	}
 
-	cmd.ui.Say("Deleting app %s...", terminal.EntityNameColor(appName))
+	cmd.ui.Status(i18n.T("delete_app.deleting_app"), terminal.EntityNameColor(appName))
 
 	app, apiResponse := cmd.appRepo.FindByName(appName)
 
 	if apiResponse.IsError() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	if apiResponse.IsNotFound() {
 		cmd.ui.Ok()
-		cmd.ui.Warn("App %s does not exist.", appName)
+		cmd.ui.Warn(i18n.T("delete_app.app_not_found"), appName)
 		return
 	}
 
 	apiResponse = cmd.appRepo.Delete(app)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
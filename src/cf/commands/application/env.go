@@ -1,6 +1,7 @@
 package application
 
 import (
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -20,7 +21,7 @@ func NewEnv(ui terminal.UI) (cmd *Env) {
 
 func (cmd *Env) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) < 1 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "env")
 		return
 	}
@@ -34,18 +35,19 @@ func (cmd *Env) GetRequirements(reqFactory requirements.Factory, c *cli.Context)
 	return
 }
 
-func (cmd *Env) Run(c *cli.Context) {
+func (cmd *Env) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 
-	cmd.ui.Say("Getting env variables for %s...", terminal.EntityNameColor(app.Name))
+	cmd.ui.Status(i18n.T("env.getting_variables"), terminal.EntityNameColor(app.Name))
 	envVars := app.EnvironmentVars
 
 	cmd.ui.Ok()
 	if len(envVars) == 0 {
-		cmd.ui.Say("No env variables exist")
+		cmd.ui.Say(i18n.T("env.no_variables"))
 		return
 	}
 	for key, value := range envVars {
 		cmd.ui.Say("%s: %s", key, terminal.EntityNameColor(value))
 	}
+	return
 }
@@ -2,6 +2,7 @@ package application
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -26,7 +27,7 @@ func NewEvents(ui terminal.UI, eventsRepo api.AppEventsRepository) (cmd *Events)
 
 func (cmd *Events) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 1 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "events")
 		return
 	}
@@ -41,23 +42,29 @@ func (cmd *Events) GetRequirements(reqFactory requirements.Factory, c *cli.Conte
 	return
 }
 
-func (cmd *Events) Run(c *cli.Context) {
+func (cmd *Events) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 
-	cmd.ui.Say("Getting events for %s...", terminal.EntityNameColor(app.Name))
+	cmd.ui.Status(i18n.T("events.getting_events"), terminal.EntityNameColor(app.Name))
 	cmd.ui.Ok()
 
 	appEvents, apiStatus := cmd.eventsRepo.ListEvents(app)
 	if !apiStatus.IsSuccessful() {
-		cmd.ui.Failed("Failed fetching events.\n%s", apiStatus.Message)
+		cmd.ui.Failed(i18n.T("events.error_fetching_events"), apiStatus.Message)
+		return apiStatus.AsError()
+	}
+
+	err = cmd.ui.Render(appEvents)
+	if err != nil {
+		return
 	}
 
 	if len(appEvents) == 0 {
-		cmd.ui.Say("There are no events available for %s at this time", terminal.EntityNameColor(app.Name))
+		cmd.ui.Say(i18n.T("events.no_events"), terminal.EntityNameColor(app.Name))
 		return
 	}
 
-	cmd.ui.Say("Showing all %d event(s)...\n", len(appEvents))
+	cmd.ui.Status(i18n.T("events.showing_all_events"), len(appEvents))
 
 	table := [][]string{
 		[]string{"time", "instance", "description", "exit status"},
@@ -73,6 +80,5 @@ func (cmd *Events) Run(c *cli.Context) {
 		})
 	}
 
-	cmd.ui.DisplayTable(table)
-
+	return cmd.ui.DisplayTable(table)
 }
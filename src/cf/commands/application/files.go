@@ -2,6 +2,7 @@ package application
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -23,7 +24,7 @@ func NewFiles(ui terminal.UI, appFilesRepo api.AppFilesRepository) (cmd *Files)
 
 func (cmd *Files) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) < 1 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "files")
 		return
 	}
@@ -38,8 +39,8 @@ func (cmd *Files) GetRequirements(reqFactory requirements.Factory, c *cli.Contex
 	return
 }
 
-func (cmd *Files) Run(c *cli.Context) {
-	cmd.ui.Say("Getting files...")
+func (cmd *Files) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("files.getting_files"))
 
 	app := cmd.appReq.GetApplication()
 
@@ -51,9 +52,10 @@ func (cmd *Files) Run(c *cli.Context) {
 	list, apiResponse := cmd.appFilesRepo.ListFiles(app, path)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
 	cmd.ui.Say(list)
+	return
 }
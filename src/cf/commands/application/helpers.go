@@ -2,6 +2,7 @@ package application
 
 import (
 	"cf"
+	"cf/i18n"
 	"cf/terminal"
 	"errors"
 	"fmt"
@@ -69,7 +70,7 @@ func bytesFromString(s string) (bytes uint64, err error) {
 	}
 
 	if bytes == 0 {
-		err = errors.New("Could not parse byte string")
+		err = errors.New(i18n.T("helpers.invalid_byte_string"))
 	}
 
 	return
@@ -2,6 +2,7 @@ package application
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -27,20 +28,24 @@ func (cmd ListApps) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 	return
 }
 
-func (cmd ListApps) Run(c *cli.Context) {
-	cmd.ui.Say("Getting apps in %s...",
+func (cmd ListApps) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("list_apps.getting_apps"),
 		terminal.EntityNameColor(cmd.spaceRepo.GetCurrentSpace().Name))
 
 	space, apiResponse := cmd.spaceRepo.GetSummary()
 
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	apps := space.Applications
 
 	cmd.ui.Ok()
+	err = cmd.ui.Render(apps)
+	if err != nil {
+		return
+	}
 
 	table := [][]string{
 		[]string{"name", "state", "instances", "memory", "disk", "urls"},
@@ -57,5 +62,5 @@ func (cmd ListApps) Run(c *cli.Context) {
 		})
 	}
 
-	cmd.ui.DisplayTable(table)
+	return cmd.ui.DisplayTable(table)
 }
@@ -46,6 +46,8 @@ func TestApps(t *testing.T) {
 	assert.Contains(t, ui.Outputs[4], "256M")
 	assert.Contains(t, ui.Outputs[4], "1G")
 	assert.Contains(t, ui.Outputs[4], "app2.cfapps.io")
+
+	assert.Equal(t, ui.Rendered, []interface{}{apps})
 }
 
 func TestAppsRequiresLogin(t *testing.T) {
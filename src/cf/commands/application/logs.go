@@ -2,6 +2,7 @@ package application
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -25,7 +26,7 @@ func NewLogs(ui terminal.UI, logsRepo api.LogsRepository) (cmd *Logs) {
 func (cmd *Logs) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 1 {
 		cmd.ui.FailWithUsage(c, "logs")
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		return
 	}
 
@@ -39,24 +40,22 @@ func (cmd *Logs) GetRequirements(reqFactory requirements.Factory, c *cli.Context
 	return
 }
 
-func (cmd *Logs) Run(c *cli.Context) {
+func (cmd *Logs) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 
 	onMessage := func(msg *logmessage.Message) {
 		cmd.ui.Say(logMessageOutput(msg))
 	}
 
-	var err error
-
 	if c.Bool("recent") {
 		onConnect := func() {
-			cmd.ui.Say("Connected, dumping recent logs...")
+			cmd.ui.Status(i18n.T("logs.connected_recent"))
 		}
 
 		err = cmd.logsRepo.RecentLogsFor(app, onConnect, onMessage)
 	} else {
 		onConnect := func() {
-			cmd.ui.Say("Connected, tailing...")
+			cmd.ui.Status(i18n.T("logs.connected_tailing"))
 		}
 
 		err = cmd.logsRepo.TailLogsFor(app, onConnect, onMessage, 2)
@@ -64,6 +63,7 @@ func (cmd *Logs) Run(c *cli.Context) {
 
 	if err != nil {
 		cmd.ui.Failed(err.Error())
-		return
+		return err
 	}
+	return
 }
@@ -3,6 +3,7 @@ package application
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/net"
 	"cf/requirements"
 	"cf/terminal"
@@ -47,12 +48,10 @@ func (cmd Push) GetRequirements(reqFactory requirements.Factory, c *cli.Context)
 	return
 }
 
-func (cmd Push) Run(c *cli.Context) {
-	var err error
-
+func (cmd Push) Run(c *cli.Context) (err error) {
 	if len(c.Args()) != 1 {
 		cmd.ui.FailWithUsage(c, "push")
-		return
+		return cf.NewCommandError(cf.UsageError, "%s", i18n.T("push.incorrect_usage"))
 	}
 
 	appName := c.Args()[0]
@@ -60,43 +59,47 @@ func (cmd Push) Run(c *cli.Context) {
 	app, apiResponse := cmd.appRepo.FindByName(appName)
 	if apiResponse.IsError() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	if apiResponse.IsNotFound() {
+		// createApp reports its own failures
 		app, apiResponse = cmd.createApp(appName, c)
-
 		if apiResponse.IsNotSuccessful() {
-			cmd.ui.Failed(apiResponse.Message)
-			return
+			return apiResponse.AsError()
 		}
 	}
 
-	cmd.ui.Say("Uploading %s...", terminal.EntityNameColor(app.Name))
+	cmd.ui.Status(i18n.T("push.uploading"), terminal.EntityNameColor(app.Name))
 
 	dir := c.String("p")
 	if dir == "" {
 		dir, err = os.Getwd()
 		if err != nil {
 			cmd.ui.Failed(err.Error())
-			return
+			return err
 		}
 	}
 
 	apiResponse = cmd.appBitsRepo.UploadApp(app, dir)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 	cmd.ui.Ok()
 
-	updatedApp, _ := cmd.stopper.ApplicationStop(app)
+	updatedApp, err := cmd.stopper.ApplicationStop(app)
+	if err != nil {
+		return
+	}
+
 	if !c.Bool("no-start") {
 		if c.String("b") != "" {
 			updatedApp.BuildpackUrl = c.String("b")
 		}
-		cmd.starter.ApplicationStart(updatedApp)
+		_, err = cmd.starter.ApplicationStart(updatedApp)
 	}
+	return
 }
 
 func (cmd Push) createApp(appName string, c *cli.Context) (app cf.Application, apiResponse net.ApiResponse) {
@@ -118,10 +121,10 @@ func (cmd Push) createApp(appName string, c *cli.Context) (app cf.Application, a
 			return
 		}
 		newApp.Stack = stack
-		cmd.ui.Say("Using stack %s...", terminal.EntityNameColor(stack.Name))
+		cmd.ui.Status(i18n.T("push.using_stack"), terminal.EntityNameColor(stack.Name))
 	}
 
-	cmd.ui.Say("Creating %s...", terminal.EntityNameColor(appName))
+	cmd.ui.Status(i18n.T("push.creating"), terminal.EntityNameColor(appName))
 	app, apiResponse = cmd.appRepo.Create(newApp)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
@@ -136,13 +139,13 @@ func (cmd Push) createApp(appName string, c *cli.Context) (app cf.Application, a
 			hostName = app.Name
 		}
 
-		cmd.bindAppToRoute(app, hostName, domainName)
+		apiResponse = cmd.bindAppToRoute(app, hostName, domainName)
 	}
 
 	return
 }
 
-func (cmd Push) bindAppToRoute(app cf.Application, hostName, domainName string) {
+func (cmd Push) bindAppToRoute(app cf.Application, hostName, domainName string) (apiResponse net.ApiResponse) {
 
 	domain, apiResponse := cmd.domainRepo.FindByNameInCurrentSpace(domainName)
 
@@ -157,7 +160,7 @@ func (cmd Push) bindAppToRoute(app cf.Application, hostName, domainName string)
 		newRoute := cf.Route{Host: hostName}
 
 		createdUrl := fmt.Sprintf("%s.%s", newRoute.Host, domain.Name)
-		cmd.ui.Say("Creating route %s...", terminal.EntityNameColor(createdUrl))
+		cmd.ui.Status(i18n.T("push.creating_route"), terminal.EntityNameColor(createdUrl))
 		route, apiResponse = cmd.routeRepo.Create(newRoute, domain)
 		if apiResponse.IsNotSuccessful() {
 			cmd.ui.Failed(apiResponse.Message)
@@ -166,11 +169,11 @@ func (cmd Push) bindAppToRoute(app cf.Application, hostName, domainName string)
 		cmd.ui.Ok()
 	} else {
 		existingUrl := fmt.Sprintf("%s.%s", route.Host, domain.Name)
-		cmd.ui.Say("Using route %s", terminal.EntityNameColor(existingUrl))
+		cmd.ui.Status(i18n.T("push.using_route"), terminal.EntityNameColor(existingUrl))
 	}
 
 	finalUrl := fmt.Sprintf("%s.%s", route.Host, domain.Name)
-	cmd.ui.Say("Binding %s to %s...", terminal.EntityNameColor(finalUrl), terminal.EntityNameColor(app.Name))
+	cmd.ui.Status(i18n.T("push.binding_route"), terminal.EntityNameColor(finalUrl), terminal.EntityNameColor(app.Name))
 	apiResponse = cmd.routeRepo.Bind(route, app)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
@@ -178,6 +181,7 @@ func (cmd Push) bindAppToRoute(app cf.Application, hostName, domainName string)
 	}
 
 	cmd.ui.Ok()
+	return
 }
 
 func getMemoryLimit(arg string) (memory uint64) {
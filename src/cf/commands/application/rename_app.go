@@ -2,6 +2,7 @@ package application
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -23,7 +24,7 @@ func NewRenameApp(ui terminal.UI, appRepo api.ApplicationRepository) (cmd *Renam
 
 func (cmd *RenameApp) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "rename")
 		return
 	}
@@ -35,15 +36,16 @@ func (cmd *RenameApp) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 	return
 }
 
-func (cmd *RenameApp) Run(c *cli.Context) {
+func (cmd *RenameApp) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 	new_name := c.Args()[1]
-	cmd.ui.Say("Renaming %s to %s...", terminal.EntityNameColor(app.Name), terminal.EntityNameColor(new_name))
+	cmd.ui.Status(i18n.T("rename_app.renaming"), terminal.EntityNameColor(app.Name), terminal.EntityNameColor(new_name))
 
 	apiResponse := cmd.appRepo.Rename(app, new_name)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 	cmd.ui.Ok()
+	return
 }
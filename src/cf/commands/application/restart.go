@@ -2,6 +2,7 @@ package application
 
 import (
 	"cf"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -16,7 +17,7 @@ type Restart struct {
 }
 
 type ApplicationRestarter interface {
-	ApplicationRestart(app cf.Application)
+	ApplicationRestart(app cf.Application) (err error)
 }
 
 func NewRestart(ui terminal.UI, starter ApplicationStarter, stopper ApplicationStopper) (cmd *Restart) {
@@ -29,7 +30,7 @@ func NewRestart(ui terminal.UI, starter ApplicationStarter, stopper ApplicationS
 
 func (cmd *Restart) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) == 0 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "restart")
 		return
 	}
@@ -44,21 +45,18 @@ func (cmd *Restart) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 	return
 }
 
-func (cmd *Restart) Run(c *cli.Context) {
+func (cmd *Restart) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
-	cmd.ApplicationRestart(app)
+	return cmd.ApplicationRestart(app)
 }
 
-func (cmd *Restart) ApplicationRestart(app cf.Application) {
+// The stopper and starter report their own failures
+func (cmd *Restart) ApplicationRestart(app cf.Application) (err error) {
 	stoppedApp, err := cmd.stopper.ApplicationStop(app)
 	if err != nil {
-		cmd.ui.Failed(err.Error())
 		return
 	}
 
 	_, err = cmd.starter.ApplicationStart(stoppedApp)
-	if err != nil {
-		cmd.ui.Failed(err.Error())
-		return
-	}
+	return
 }
@@ -3,6 +3,7 @@ package application
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -27,7 +28,7 @@ func NewScale(ui terminal.UI, restarter ApplicationRestarter, appRepo api.Applic
 func (cmd *Scale) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 
 	if len(c.Args()) < 1 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "scale")
 		return
 	}
@@ -42,9 +43,9 @@ func (cmd *Scale) GetRequirements(reqFactory requirements.Factory, c *cli.Contex
 	return
 }
 
-func (cmd *Scale) Run(c *cli.Context) {
+func (cmd *Scale) Run(c *cli.Context) (err error) {
 	currentApp := cmd.appReq.GetApplication()
-	cmd.ui.Say("Scaling app %s...", terminal.EntityNameColor(currentApp.Name))
+	cmd.ui.Status(i18n.T("scale.scaling"), terminal.EntityNameColor(currentApp.Name))
 
 	changedApp := cf.Application{
 		Guid: currentApp.Guid,
@@ -52,23 +53,28 @@ func (cmd *Scale) Run(c *cli.Context) {
 
 	diskQuota, err := extractMegaBytes(c.String("d"))
 	if err != nil {
-		cmd.ui.Say("Invalid value for disk quota")
+		cmd.ui.Say(i18n.T("scale.invalid_disk_quota"))
 		cmd.ui.FailWithUsage(c, "scale")
-		return
+		return cf.NewCommandError(cf.UsageError, "%s", i18n.T("scale.incorrect_usage"))
 	}
 	changedApp.DiskQuota = diskQuota
 
 	memory, err := extractMegaBytes(c.String("m"))
 	if err != nil {
-		cmd.ui.Say("Invalid value for memory")
+		cmd.ui.Say(i18n.T("scale.invalid_memory"))
 		cmd.ui.FailWithUsage(c, "scale")
-		return
+		return cf.NewCommandError(cf.UsageError, "%s", i18n.T("scale.incorrect_usage"))
 	}
 	changedApp.Memory = memory
 	changedApp.Instances = c.Int("i")
 
-	cmd.appRepo.Scale(changedApp)
-	cmd.restarter.ApplicationRestart(currentApp)
+	apiResponse := cmd.appRepo.Scale(changedApp)
+	if apiResponse.IsNotSuccessful() {
+		cmd.ui.Failed(apiResponse.Message)
+		return apiResponse.AsError()
+	}
+
+	return cmd.restarter.ApplicationRestart(currentApp)
 }
 
 func extractMegaBytes(arg string) (megaBytes uint64, err error) {
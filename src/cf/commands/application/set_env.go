@@ -3,6 +3,7 @@ package application
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -24,7 +25,7 @@ func NewSetEnv(ui terminal.UI, appRepo api.ApplicationRepository) (se *SetEnv) {
 
 func (cmd *SetEnv) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) < 3 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "set-env")
 		return
 	}
@@ -38,12 +39,12 @@ func (cmd *SetEnv) GetRequirements(reqFactory requirements.Factory, c *cli.Conte
 	return
 }
 
-func (se *SetEnv) Run(c *cli.Context) {
+func (se *SetEnv) Run(c *cli.Context) (err error) {
 	varName := c.Args()[1]
 	varValue := c.Args()[2]
 	app := se.appReq.GetApplication()
 
-	se.ui.Say("Updating env variable %s for app %s...", varName, app.Name)
+	se.ui.Status(i18n.T("set_env.updating"), varName, app.Name)
 
 	var envVars map[string]string
 
@@ -55,7 +56,7 @@ func (se *SetEnv) Run(c *cli.Context) {
 
 	if envVarFound(varName, envVars) {
 		se.ui.Ok()
-		se.ui.Warn("Env var %s was already set.", varName)
+		se.ui.Warn(i18n.T("set_env.already_set"), varName)
 		return
 	}
 
@@ -65,9 +66,10 @@ func (se *SetEnv) Run(c *cli.Context) {
 
 	if apiResponse.IsNotSuccessful() {
 		se.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	se.ui.Ok()
-	se.ui.Say("TIP: Use '%s push' to ensure your env variable changes take effect", cf.Name)
+	se.ui.Status(i18n.T("set_env.push_tip"), cf.Name)
+	return
 }
@@ -2,6 +2,7 @@ package application
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -25,7 +26,7 @@ func NewShowApp(ui terminal.UI, appSummaryRepo api.AppSummaryRepository) (cmd *S
 
 func (cmd *ShowApp) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) < 1 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "app")
 		return
 	}
@@ -40,20 +41,24 @@ func (cmd *ShowApp) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 	return
 }
 
-func (cmd *ShowApp) Run(c *cli.Context) {
+func (cmd *ShowApp) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
-	cmd.ui.Say("Showing health and status for app %s...", terminal.EntityNameColor(app.Name))
+	cmd.ui.Status(i18n.T("show_app.showing_health"), terminal.EntityNameColor(app.Name))
 
 	summary, apiResponse := cmd.appSummaryRepo.GetSummary(app)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	err = cmd.ui.Render(summary)
+	if err != nil {
+		return
+	}
 	cmd.ui.Say("\n%s %s", terminal.HeaderColor("state:"), coloredAppState(summary.App))
 	cmd.ui.Say("%s %s", terminal.HeaderColor("instances:"), coloredAppInstaces(summary.App))
-	cmd.ui.Say("%s %s x %d instances", terminal.HeaderColor("usage:"), byteSize(summary.App.Memory*MEGABYTE), summary.App.Instances)
+	cmd.ui.Say(i18n.T("show_app.instances"), terminal.HeaderColor("usage:"), byteSize(summary.App.Memory*MEGABYTE), summary.App.Instances)
 	cmd.ui.Say("%s %s\n", terminal.HeaderColor("urls:"), strings.Join(summary.App.Urls, ", "))
 
 	table := [][]string{
@@ -66,10 +71,10 @@ func (cmd *ShowApp) Run(c *cli.Context) {
 			coloredInstanceState(instance),
 			instance.Since.Format("2006-01-02 03:04:05 PM"),
 			fmt.Sprintf("%.1f%%", instance.CpuUsage),
-			fmt.Sprintf("%s of %s", byteSize(instance.MemUsage), byteSize(instance.MemQuota)),
-			fmt.Sprintf("%s of %s", byteSize(instance.DiskUsage), byteSize(instance.DiskQuota)),
+			fmt.Sprintf(i18n.T("show_app.usage_of_quota"), byteSize(instance.MemUsage), byteSize(instance.MemQuota)),
+			fmt.Sprintf(i18n.T("show_app.usage_of_quota"), byteSize(instance.DiskUsage), byteSize(instance.DiskQuota)),
 		})
 	}
 
-	cmd.ui.DisplayTable(table)
+	return cmd.ui.DisplayTable(table)
 }
@@ -4,6 +4,7 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -36,7 +37,7 @@ func NewStart(ui terminal.UI, config *configuration.Configuration, appRepo api.A
 
 func (cmd *Start) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) == 0 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "start")
 		return
 	}
@@ -47,22 +48,23 @@ func (cmd *Start) GetRequirements(reqFactory requirements.Factory, c *cli.Contex
 	return
 }
 
-func (cmd *Start) Run(c *cli.Context) {
-	cmd.ApplicationStart(cmd.appReq.GetApplication())
+func (cmd *Start) Run(c *cli.Context) (err error) {
+	_, err = cmd.ApplicationStart(cmd.appReq.GetApplication())
+	return
 }
 
 func (cmd *Start) ApplicationStart(app cf.Application) (updatedApp cf.Application, err error) {
 	if app.State == "started" {
-		cmd.ui.Say(terminal.WarningColor("App " + app.Name + " is already started"))
+		cmd.ui.Warn(i18n.T("start.already_started"), app.Name)
 		return
 	}
 
-	cmd.ui.Say("Starting %s...", terminal.EntityNameColor(app.Name))
+	cmd.ui.Status(i18n.T("start.starting"), terminal.EntityNameColor(app.Name))
 
 	updatedApp, apiResponse := cmd.appRepo.Start(app)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return updatedApp, apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
@@ -71,9 +73,9 @@ func (cmd *Start) ApplicationStart(app cf.Application) (updatedApp cf.Applicatio
 
 	for apiResponse.IsNotSuccessful() {
 		if apiResponse.ErrorCode != cf.APP_NOT_STAGED {
-			cmd.ui.Say("")
+			cmd.ui.Status("")
 			cmd.ui.Failed(apiResponse.Message)
-			return
+			return updatedApp, apiResponse.AsError()
 		}
 
 		cmd.ui.Wait(1 * time.Second)
@@ -81,19 +83,21 @@ func (cmd *Start) ApplicationStart(app cf.Application) (updatedApp cf.Applicatio
 		cmd.ui.LoadingIndication()
 	}
 
-	cmd.ui.Say("")
+	cmd.ui.Status("")
 
 	cmd.startTime = time.Now()
 
-	for cmd.displayInstancesStatus(app, instances) {
+	notFinished, err := cmd.displayInstancesStatus(app, instances)
+	for notFinished {
 		cmd.ui.Wait(1 * time.Second)
 		instances, _ = cmd.appRepo.GetInstances(app)
+		notFinished, err = cmd.displayInstancesStatus(app, instances)
 	}
 
 	return
 }
 
-func (cmd Start) displayInstancesStatus(app cf.Application, instances []cf.ApplicationInstance) (notFinished bool) {
+func (cmd Start) displayInstancesStatus(app cf.Application, instances []cf.ApplicationInstance) (notFinished bool, err error) {
 	totalCount := len(instances)
 	runningCount, startingCount, flappingCount, downCount := 0, 0, 0, 0
 
@@ -111,41 +115,44 @@ func (cmd Start) displayInstancesStatus(app cf.Application, instances []cf.Appli
 	}
 
 	if flappingCount > 0 {
-		cmd.ui.Failed("Start unsuccessful")
-		return false
+		err = cf.NewCommandError(cf.GeneralError, "%s", i18n.T("start.unsuccessful"))
+		cmd.ui.Failed(err.Error())
+		return
 	}
 
 	anyInstanceRunning := runningCount > 0
 
 	if anyInstanceRunning {
 		if len(app.Urls) == 0 {
-			cmd.ui.Say("Started")
+			cmd.ui.Say(i18n.T("start.started"))
 		} else {
-			cmd.ui.Say("Started: app %s available at %s", app.Name, app.Urls[0])
+			cmd.ui.Say(i18n.T("start.started_at_url"), app.Name, app.Urls[0])
 		}
-		return false
+		return
 	} else {
 		details := instancesDetails(runningCount, startingCount, downCount)
-		cmd.ui.Say("%d of %d instances running (%s)", runningCount, totalCount, details)
+		cmd.ui.Status(i18n.T("start.instances_running"), runningCount, totalCount, details)
 	}
 
 	if time.Since(cmd.startTime) > cmd.config.ApplicationStartTimeout*time.Second {
-		cmd.ui.Failed("Start app timeout")
-		return false
+		err = cf.NewCommandError(cf.GeneralError, "%s", i18n.T("start.timeout"))
+		cmd.ui.Failed(err.Error())
+		return
 	}
 
-	return totalCount > runningCount
+	notFinished = totalCount > runningCount
+	return
 }
 
 func instancesDetails(runningCount int, startingCount int, downCount int) string {
 	details := []string{}
 
 	if startingCount > 0 {
-		details = append(details, fmt.Sprintf("%d starting", startingCount))
+		details = append(details, fmt.Sprintf(i18n.T("start.instances_starting"), startingCount))
 	}
 
 	if downCount > 0 {
-		details = append(details, fmt.Sprintf("%d down", downCount))
+		details = append(details, fmt.Sprintf(i18n.T("start.instances_down"), downCount))
 	}
 
 	return strings.Join(details, ", ")
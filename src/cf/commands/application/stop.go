@@ -3,6 +3,7 @@ package application
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -29,7 +30,7 @@ func NewStop(ui terminal.UI, appRepo api.ApplicationRepository) (cmd *Stop) {
 
 func (cmd *Stop) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) == 0 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "stop")
 		return
 	}
@@ -43,15 +44,15 @@ func (cmd *Stop) GetRequirements(reqFactory requirements.Factory, c *cli.Context
 func (cmd *Stop) ApplicationStop(app cf.Application) (updatedApp cf.Application, err error) {
 	if app.State == "stopped" {
 		updatedApp = app
-		cmd.ui.Say(terminal.WarningColor("App " + app.Name + " is already stopped"))
+		cmd.ui.Warn(i18n.T("stop.already_stopped"), app.Name)
 		return
 	}
 
-	cmd.ui.Say("Stopping %s...", terminal.EntityNameColor(app.Name))
+	cmd.ui.Status(i18n.T("stop.stopping"), terminal.EntityNameColor(app.Name))
 
 	updatedApp, apiResponse := cmd.appRepo.Stop(app)
 	if apiResponse.IsNotSuccessful() {
-		err = errors.New(apiResponse.Message)
+		err = apiResponse.AsError()
 		cmd.ui.Failed(apiResponse.Message)
 		return
 	}
@@ -60,7 +61,8 @@ func (cmd *Stop) ApplicationStop(app cf.Application) (updatedApp cf.Application,
 	return
 }
 
-func (cmd *Stop) Run(c *cli.Context) {
+func (cmd *Stop) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
-	cmd.ApplicationStop(app)
+	_, err = cmd.ApplicationStop(app)
+	return
 }
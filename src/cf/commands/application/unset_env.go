@@ -3,6 +3,7 @@ package application
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -24,7 +25,7 @@ func NewUnsetEnv(ui terminal.UI, appRepo api.ApplicationRepository) (cmd *UnsetE
 
 func (cmd *UnsetEnv) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) < 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "unset-env")
 		return
 	}
@@ -38,17 +39,17 @@ func (cmd *UnsetEnv) GetRequirements(reqFactory requirements.Factory, c *cli.Con
 	return
 }
 
-func (cmd *UnsetEnv) Run(c *cli.Context) {
+func (cmd *UnsetEnv) Run(c *cli.Context) (err error) {
 	varName := c.Args()[1]
 	app := cmd.appReq.GetApplication()
 
-	cmd.ui.Say("Removing env variable %s for app %s...", terminal.EntityNameColor(varName), terminal.EntityNameColor(app.Name))
+	cmd.ui.Status(i18n.T("unset_env.removing"), terminal.EntityNameColor(varName), terminal.EntityNameColor(app.Name))
 
 	envVars := app.EnvironmentVars
 
 	if !envVarFound(varName, envVars) {
 		cmd.ui.Ok()
-		cmd.ui.Warn("Env variable %s was not set.", varName)
+		cmd.ui.Warn(i18n.T("unset_env.not_set"), varName)
 		return
 	}
 
@@ -58,9 +59,10 @@ func (cmd *UnsetEnv) Run(c *cli.Context) {
 
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.Say("TIP: Use '%s push' to ensure your env variable changes take effect", cf.Name)
+	cmd.ui.Status(i18n.T("unset_env.push_tip"), cf.Name)
+	return
 }
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/i18n"
+	"cf/net"
+	"cf/requirements"
+	"cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type Authenticate struct {
+	ui            terminal.UI
+	config        *configuration.Configuration
+	authenticator api.AuthenticationRepository
+}
+
+func NewAuthenticate(ui terminal.UI, configRepo configuration.ConfigurationRepository, authenticator api.AuthenticationRepository) (cmd Authenticate) {
+	cmd.ui = ui
+	cmd.config, _ = configRepo.Get()
+	cmd.authenticator = authenticator
+	return
+}
+
+func (cmd Authenticate) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
+		cmd.ui.FailWithUsage(c, "auth")
+		return
+	}
+	return
+}
+
+func (cmd Authenticate) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("auth.api_endpoint"), terminal.EntityNameColor(cmd.config.Target))
+	cmd.ui.Status(i18n.T("auth.authenticating"))
+
+	var apiResponse net.ApiResponse
+	if c.Bool("client-credentials") {
+		apiResponse = cmd.authenticator.AuthenticateClient(c.Args()[0], c.Args()[1])
+	} else {
+		apiResponse = cmd.authenticator.Authenticate(map[string]string{
+			"username": c.Args()[0],
+			"password": c.Args()[1],
+		})
+	}
+
+	if apiResponse.IsNotSuccessful() {
+		cmd.ui.Failed(apiResponse.Message)
+		return apiResponse.AsError()
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Status(i18n.T("auth.view_target_tip"), terminal.CommandColor(cf.Name+" target"))
+	return
+}
@@ -0,0 +1,67 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	testapi "testhelpers/api"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+	"testing"
+)
+
+func TestAuthenticateFailsWithUsage(t *testing.T) {
+	ui, _ := callAuthenticate([]string{}, &testapi.FakeAuthenticationRepository{})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui, _ = callAuthenticate([]string{"my-username"}, &testapi.FakeAuthenticationRepository{})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui, _ = callAuthenticate([]string{"my-username", "my-password"}, &testapi.FakeAuthenticationRepository{})
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestAuthenticatingAUser(t *testing.T) {
+	auth := &testapi.FakeAuthenticationRepository{AccessToken: "my_access_token"}
+	ui, _ := callAuthenticate([]string{"user@example.com", "password"}, auth)
+
+	assert.Contains(t, ui.Outputs[1], "Authenticating...")
+	assert.Contains(t, ui.Outputs[2], "OK")
+	assert.Equal(t, auth.Email, "user@example.com")
+	assert.Equal(t, auth.Password, "password")
+	assert.Equal(t, auth.ClientId, "")
+}
+
+func TestAuthenticatingAClient(t *testing.T) {
+	auth := &testapi.FakeAuthenticationRepository{AccessToken: "my_access_token"}
+	ui, _ := callAuthenticate([]string{"--client-credentials", "my-client", "my-secret"}, auth)
+
+	assert.Contains(t, ui.Outputs[2], "OK")
+	assert.Equal(t, auth.ClientId, "my-client")
+	assert.Equal(t, auth.ClientSecret, "my-secret")
+	assert.Equal(t, auth.Email, "")
+
+	savedConfig := testconfig.SavedConfiguration
+	assert.Equal(t, savedConfig.AccessToken, "my_access_token")
+	assert.Equal(t, savedConfig.ClientId, "my-client")
+}
+
+func TestAuthenticatingAClientWithBadCredentials(t *testing.T) {
+	auth := &testapi.FakeAuthenticationRepository{AuthError: true}
+	ui, _ := callAuthenticate([]string{"--client-credentials", "my-client", "bad-secret"}, auth)
+
+	assert.Equal(t, ui.Outputs[2], "FAILED")
+	assert.Contains(t, ui.Outputs[3], "Error authenticating")
+}
+
+func callAuthenticate(args []string, auth *testapi.FakeAuthenticationRepository) (ui *testterm.FakeUI, configRepo testconfig.FakeConfigRepository) {
+	configRepo = testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+	auth.ConfigRepo = configRepo
+
+	ui = new(testterm.FakeUI)
+	cmd := NewAuthenticate(ui, configRepo, auth)
+	testcmd.RunCommand(cmd, testcmd.NewContext("auth", args), &testreq.FakeReqFactory{})
+	return
+}
@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type Completion struct {
+	ui        terminal.UI
+	config    *configuration.Configuration
+	cache     configuration.CompletionCache
+	orgRepo   api.OrganizationRepository
+	spaceRepo api.SpaceRepository
+}
+
+func NewCompletion(ui terminal.UI, config *configuration.Configuration, cache configuration.CompletionCache, orgRepo api.OrganizationRepository, spaceRepo api.SpaceRepository) (cmd Completion) {
+	cmd.ui = ui
+	cmd.config = config
+	cmd.cache = cache
+	cmd.orgRepo = orgRepo
+	cmd.spaceRepo = spaceRepo
+	return
+}
+
+func (cmd Completion) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	args := c.Args()
+
+	validUsage := false
+	switch len(args) {
+	case 1:
+		_, validUsage = completionScriptWriters[args[0]]
+	case 2:
+		validUsage = args[0] == "names" && isCompletionKind(args[1])
+	}
+
+	if !validUsage {
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
+		cmd.ui.FailWithUsage(c, "completion")
+	}
+	return
+}
+
+func (cmd Completion) Run(c *cli.Context) (err error) {
+	if c.Args()[0] == "names" {
+		cmd.sayNames(c.Args()[1])
+		return
+	}
+
+	script := completionScriptWriters[c.Args()[0]](completionSpecs(c.App.Commands))
+	cmd.ui.Say("%s", script)
+	return
+}
+
+// Prints the names for a completion script. Completion has to stay quiet, so
+// failures just leave nothing to complete.
+func (cmd Completion) sayNames(kind string) {
+	if !cmd.config.IsLoggedIn() {
+		return
+	}
+
+	names, found := cmd.cache.Names(kind, configuration.CompletionScope(cmd.config, kind))
+	if !found {
+		names = cmd.fetchNames(kind)
+	}
+
+	for _, name := range names {
+		cmd.ui.Say("%s", name)
+	}
+}
+
+// Lists the names from the repositories, which cache them as they list them.
+func (cmd Completion) fetchNames(kind string) (names []string) {
+	switch kind {
+	case configuration.CompletionApps, configuration.CompletionServices:
+		if !cmd.config.HasSpace() {
+			return
+		}
+
+		space, apiResponse := cmd.spaceRepo.GetSummary()
+		if apiResponse.IsNotSuccessful() {
+			return
+		}
+
+		if kind == configuration.CompletionApps {
+			for _, app := range space.Applications {
+				names = append(names, app.Name)
+			}
+		} else {
+			for _, instance := range space.ServiceInstances {
+				names = append(names, instance.Name)
+			}
+		}
+	case configuration.CompletionSpaces:
+		if !cmd.config.HasOrganization() {
+			return
+		}
+
+		spaces, apiResponse := cmd.spaceRepo.FindAll()
+		if apiResponse.IsNotSuccessful() {
+			return
+		}
+
+		for _, space := range spaces {
+			names = append(names, space.Name)
+		}
+	case configuration.CompletionOrgs:
+		orgs, apiResponse := cmd.orgRepo.FindAll()
+		if apiResponse.IsNotSuccessful() {
+			return
+		}
+
+		for _, org := range orgs {
+			names = append(names, org.Name)
+		}
+	}
+	return
+}
+
+func isCompletionKind(kind string) bool {
+	switch kind {
+	case configuration.CompletionApps, configuration.CompletionServices, configuration.CompletionSpaces, configuration.CompletionOrgs:
+		return true
+	}
+	return false
+}
+
+// The command whose output the completion scripts run to complete names
+func completionNamesCommand(kind string) string {
+	return cf.Name + " completion names " + kind
+}
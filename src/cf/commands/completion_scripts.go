@@ -0,0 +1,310 @@
+package commands
+
+import (
+	"bytes"
+	"cf"
+	"cf/configuration"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"sort"
+	"strings"
+)
+
+// What the completion scripts know about a command, taken from its flags and
+// the first line of its usage.
+type completionSpec struct {
+	name        string
+	shortName   string
+	description string
+	flags       []string
+	valueFlags  []string
+	args        []string
+	flagArgs    map[string]string
+}
+
+// The completion kind for each argument placeholder used in usage lines
+var completionKindsByPlaceholder = map[string]string{
+	"APP":              configuration.CompletionApps,
+	"SERVICE_INSTANCE": configuration.CompletionServices,
+	"SPACE":            configuration.CompletionSpaces,
+	"ORG":              configuration.CompletionOrgs,
+}
+
+var completionScriptWriters = map[string]func(specs []completionSpec) string{
+	"bash": bashCompletionScript,
+	"zsh":  zshCompletionScript,
+	"fish": fishCompletionScript,
+}
+
+func completionSpecs(commands []cli.Command) (specs []completionSpec) {
+	for _, command := range commands {
+		if command.Name == "help" {
+			continue
+		}
+		specs = append(specs, newCompletionSpec(command))
+	}
+	return
+}
+
+func newCompletionSpec(command cli.Command) (spec completionSpec) {
+	spec.name = command.Name
+	spec.shortName = command.ShortName
+	spec.description = command.Description
+	spec.flagArgs = map[string]string{}
+
+	takesValue := map[string]bool{}
+	for _, flag := range command.Flags {
+		name, isValueFlag := completionFlagName(flag)
+		spec.flags = append(spec.flags, name)
+		if isValueFlag {
+			spec.valueFlags = append(spec.valueFlags, name)
+			takesValue[name] = true
+		}
+	}
+
+	usage := strings.SplitN(command.Usage, "\n", 2)[0]
+	usage = strings.NewReplacer("[", " ", "]", " ").Replace(usage)
+	words := strings.Fields(usage)
+	if len(words) < 2 {
+		return
+	}
+	words = words[2:]
+
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if strings.HasPrefix(word, "-") {
+			flag := normalizeCompletionFlag(word)
+			if takesValue[flag] && i+1 < len(words) {
+				i++
+				if kind := completionKindsByPlaceholder[words[i]]; kind != "" {
+					spec.flagArgs[flag] = kind
+				}
+			}
+			continue
+		}
+
+		// The arguments of create commands are new names
+		kind := completionKindsByPlaceholder[word]
+		if strings.HasPrefix(command.Name, "create-") {
+			kind = ""
+		}
+		spec.args = append(spec.args, kind)
+	}
+	return
+}
+
+func completionFlagName(flag cli.Flag) (name string, takesValue bool) {
+	switch flag := flag.(type) {
+	case cli.BoolFlag:
+		name = flag.Name
+	case cli.StringFlag:
+		name, takesValue = flag.Name, true
+	case cli.IntFlag:
+		name, takesValue = flag.Name, true
+	case cli.StringSliceFlag:
+		name, takesValue = flag.Name, true
+	case cli.IntSliceFlag:
+		name, takesValue = flag.Name, true
+	}
+	return normalizeCompletionFlag(name), takesValue
+}
+
+// Single letter flags are written -f and longer ones --force
+func normalizeCompletionFlag(flag string) string {
+	name := strings.TrimLeft(flag, "-")
+	if len(name) == 1 {
+		return "-" + name
+	}
+	return "--" + name
+}
+
+func sortedFlagArgs(spec completionSpec) (flags []string) {
+	for flag := range spec.flagArgs {
+		flags = append(flags, flag)
+	}
+	sort.Strings(flags)
+	return
+}
+
+func bashCompletionScript(specs []completionSpec) string {
+	script := &bytes.Buffer{}
+	fmt.Fprintf(script, "# bash completion for %s. Load it in the current shell with:\n", cf.Name)
+	fmt.Fprintf(script, "#   source <(%s completion bash)\n\n", cf.Name)
+	writeBashCompletionFunction(script, specs)
+	return script.String()
+}
+
+// zsh runs the bash completion through its bash compatibility layer.
+func zshCompletionScript(specs []completionSpec) string {
+	script := &bytes.Buffer{}
+	fmt.Fprintf(script, "#compdef %s\n", cf.Name)
+	fmt.Fprintf(script, "# zsh completion for %s. Load it in the current shell with:\n", cf.Name)
+	fmt.Fprintf(script, "#   source <(%s completion zsh)\n\n", cf.Name)
+	fmt.Fprintln(script, "autoload -U +X compinit && compinit")
+	fmt.Fprintln(script, "autoload -U +X bashcompinit && bashcompinit")
+	fmt.Fprintln(script, "")
+	writeBashCompletionFunction(script, specs)
+	return script.String()
+}
+
+func writeBashCompletionFunction(script *bytes.Buffer, specs []completionSpec) {
+	names := []string{}
+	for _, spec := range specs {
+		names = append(names, spec.name)
+	}
+
+	fmt.Fprintln(script, "_cf_complete() {")
+	fmt.Fprintln(script, `	local cur="${COMP_WORDS[COMP_CWORD]}"`)
+	fmt.Fprintln(script, `	local prev="${COMP_WORDS[COMP_CWORD-1]}"`)
+	fmt.Fprintln(script, "")
+	fmt.Fprintln(script, `	if [ "$COMP_CWORD" -eq 1 ]; then`)
+	fmt.Fprintf(script, "\t\tCOMPREPLY=( $(compgen -W \"%s\" -- \"$cur\") )\n", strings.Join(names, " "))
+	fmt.Fprintln(script, "\t\treturn")
+	fmt.Fprintln(script, "\tfi")
+	fmt.Fprintln(script, "")
+
+	fmt.Fprintln(script, `	local cmd="${COMP_WORDS[1]}"`)
+	fmt.Fprintln(script, `	case "$cmd" in`)
+	for _, spec := range specs {
+		if spec.shortName != "" {
+			fmt.Fprintf(script, "\t\t%s) cmd=%s ;;\n", spec.shortName, spec.name)
+		}
+	}
+	fmt.Fprintln(script, "\tesac")
+	fmt.Fprintln(script, "")
+
+	fmt.Fprintln(script, `	if [[ "$cur" == -* ]]; then`)
+	fmt.Fprintln(script, `		local flags=""`)
+	fmt.Fprintln(script, `		case "$cmd" in`)
+	for _, spec := range specs {
+		if len(spec.flags) > 0 {
+			fmt.Fprintf(script, "\t\t\t%s) flags=\"%s\" ;;\n", spec.name, strings.Join(spec.flags, " "))
+		}
+	}
+	fmt.Fprintln(script, "\t\tesac")
+	fmt.Fprintln(script, `		COMPREPLY=( $(compgen -W "$flags" -- "$cur") )`)
+	fmt.Fprintln(script, "\t\treturn")
+	fmt.Fprintln(script, "\tfi")
+	fmt.Fprintln(script, "")
+
+	fmt.Fprintln(script, `	local kind=""`)
+	fmt.Fprintln(script, `	case "$cmd $prev" in`)
+	for _, spec := range specs {
+		for _, flag := range sortedFlagArgs(spec) {
+			fmt.Fprintf(script, "\t\t\"%s %s\") kind=%s ;;\n", spec.name, flag, spec.flagArgs[flag])
+		}
+	}
+	fmt.Fprintln(script, "\tesac")
+	fmt.Fprintln(script, "")
+
+	valueFlags := []string{}
+	for _, spec := range specs {
+		for _, flag := range spec.valueFlags {
+			valueFlags = append(valueFlags, fmt.Sprintf(`"%s %s"`, spec.name, flag))
+		}
+	}
+
+	fmt.Fprintln(script, `	if [ -z "$kind" ]; then`)
+	fmt.Fprintln(script, "\t\tlocal position=0 i")
+	fmt.Fprintln(script, "\t\tfor (( i=2; i < COMP_CWORD; i++ )); do")
+	if len(valueFlags) > 0 {
+		fmt.Fprintln(script, `			case "$cmd ${COMP_WORDS[i-1]}" in`)
+		fmt.Fprintf(script, "\t\t\t\t%s) continue ;;\n", strings.Join(valueFlags, "|"))
+		fmt.Fprintln(script, "\t\t\tesac")
+	}
+	fmt.Fprintln(script, `			[[ "${COMP_WORDS[i]}" == -* ]] || position=$((position + 1))`)
+	fmt.Fprintln(script, "\t\tdone")
+	fmt.Fprintln(script, "")
+	fmt.Fprintln(script, `		case "$cmd $position" in`)
+	for _, spec := range specs {
+		for position, kind := range spec.args {
+			if kind != "" {
+				fmt.Fprintf(script, "\t\t\t\"%s %d\") kind=%s ;;\n", spec.name, position, kind)
+			}
+		}
+	}
+	fmt.Fprintln(script, "\t\tesac")
+	fmt.Fprintln(script, "\tfi")
+	fmt.Fprintln(script, "")
+
+	fmt.Fprintln(script, `	if [ -n "$kind" ]; then`)
+	fmt.Fprintf(script, "\t\tCOMPREPLY=( $(compgen -W \"$(%s 2>/dev/null)\" -- \"$cur\") )\n", completionNamesCommand("$kind"))
+	fmt.Fprintln(script, "\tfi")
+	fmt.Fprintln(script, "}")
+	fmt.Fprintln(script, "")
+	fmt.Fprintf(script, "complete -o default -F _cf_complete %s\n", cf.Name)
+}
+
+func fishCompletionScript(specs []completionSpec) string {
+	script := &bytes.Buffer{}
+	fmt.Fprintf(script, "# fish completion for %s. Load it in the current shell with:\n", cf.Name)
+	fmt.Fprintf(script, "#   %s completion fish | source\n\n", cf.Name)
+
+	valueFlags := []string{}
+	for _, spec := range specs {
+		for _, flag := range spec.valueFlags {
+			for _, name := range spec.names() {
+				valueFlags = append(valueFlags, fishQuote(name+" "+flag))
+			}
+		}
+	}
+
+	fmt.Fprintf(script, "set -g __cf_value_flags %s\n\n", strings.Join(valueFlags, " "))
+	fmt.Fprintln(script, "function __cf_arg_position")
+	fmt.Fprintln(script, "\tset -l words (commandline -opc)")
+	fmt.Fprintln(script, "\tset -l position 0")
+	fmt.Fprintln(script, "\tfor i in (seq 3 (count $words))")
+	fmt.Fprintln(script, `		if not string match -q -- '-*' $words[$i]; and not contains -- "$words[2] $words[(math $i - 1)]" $__cf_value_flags`)
+	fmt.Fprintln(script, "\t\t\tset position (math $position + 1)")
+	fmt.Fprintln(script, "\t\tend")
+	fmt.Fprintln(script, "\tend")
+	fmt.Fprintln(script, "\techo $position")
+	fmt.Fprintln(script, "end")
+	fmt.Fprintln(script, "")
+
+	for _, spec := range specs {
+		fmt.Fprintf(script, "complete -c %s -n __fish_use_subcommand -f -a %s -d %s\n",
+			cf.Name, fishQuote(spec.name), fishQuote(spec.description))
+	}
+
+	for _, spec := range specs {
+		seen := fishQuote("__fish_seen_subcommand_from " + strings.Join(spec.names(), " "))
+
+		for _, flag := range spec.flags {
+			option := "-l " + strings.TrimPrefix(flag, "--")
+			if !strings.HasPrefix(flag, "--") {
+				option = "-s " + strings.TrimPrefix(flag, "-")
+			}
+
+			if kind, found := spec.flagArgs[flag]; found {
+				fmt.Fprintf(script, "complete -c %s -n %s %s -x -a %s\n", cf.Name, seen, option, fishNames(kind))
+			} else {
+				fmt.Fprintf(script, "complete -c %s -n %s %s\n", cf.Name, seen, option)
+			}
+		}
+
+		for position, kind := range spec.args {
+			if kind == "" {
+				continue
+			}
+			condition := fishQuote(fmt.Sprintf("__fish_seen_subcommand_from %s; and test (__cf_arg_position) -eq %d", strings.Join(spec.names(), " "), position))
+			fmt.Fprintf(script, "complete -c %s -n %s -f -a %s\n", cf.Name, condition, fishNames(kind))
+		}
+	}
+	return script.String()
+}
+
+func (spec completionSpec) names() []string {
+	if spec.shortName == "" {
+		return []string{spec.name}
+	}
+	return []string{spec.name, spec.shortName}
+}
+
+func fishNames(kind string) string {
+	return fishQuote("(" + completionNamesCommand(kind) + " 2>/dev/null)")
+}
+
+func fishQuote(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(value) + "'"
+}
@@ -0,0 +1,112 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	testapi "testhelpers/api"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+	"testing"
+)
+
+func TestCompletionFailsWithUsage(t *testing.T) {
+	ui := callCompletion([]string{}, &configuration.Configuration{}, &testconfig.FakeCompletionCache{}, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callCompletion([]string{"powershell"}, &configuration.Configuration{}, &testconfig.FakeCompletionCache{}, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callCompletion([]string{"names", "routes"}, &configuration.Configuration{}, &testconfig.FakeCompletionCache{}, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callCompletion([]string{"bash"}, &configuration.Configuration{}, &testconfig.FakeCompletionCache{}, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestCompletionBashScript(t *testing.T) {
+	ui := callCompletion([]string{"bash"}, &configuration.Configuration{}, &testconfig.FakeCompletionCache{}, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+	script := ui.DumpOutputs()
+
+	assert.Contains(t, script, "complete -o default -F _cf_complete cf")
+	assert.Contains(t, script, "create-user-provided-service")
+	assert.Contains(t, script, "cups) cmd=create-user-provided-service ;;")
+	assert.Contains(t, script, `"start 0") kind=apps ;;`)
+	assert.Contains(t, script, `"bind-service 1") kind=services ;;`)
+	assert.Contains(t, script, `"target -o") kind=orgs ;;`)
+	assert.Contains(t, script, `target) flags="-o -s" ;;`)
+	assert.NotContains(t, script, `"create-space 0")`)
+}
+
+func TestCompletionZshScriptUsesTheBashCompletion(t *testing.T) {
+	ui := callCompletion([]string{"zsh"}, &configuration.Configuration{}, &testconfig.FakeCompletionCache{}, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+	script := ui.DumpOutputs()
+
+	assert.Contains(t, script, "#compdef cf")
+	assert.Contains(t, script, "bashcompinit")
+	assert.Contains(t, script, "complete -o default -F _cf_complete cf")
+}
+
+func TestCompletionFishScript(t *testing.T) {
+	ui := callCompletion([]string{"fish"}, &configuration.Configuration{}, &testconfig.FakeCompletionCache{}, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+	script := ui.DumpOutputs()
+
+	assert.Contains(t, script, "complete -c cf -n __fish_use_subcommand -f -a 'start' -d 'Start an app'")
+	assert.Contains(t, script, "complete -c cf -n '__fish_seen_subcommand_from target t' -s o -x -a '(cf completion names orgs 2>/dev/null)'")
+	assert.Contains(t, script, "complete -c cf -n '__fish_seen_subcommand_from start st; and test (__cf_arg_position) -eq 0' -f -a '(cf completion names apps 2>/dev/null)'")
+}
+
+func TestCompletionNamesFromTheCache(t *testing.T) {
+	config := &configuration.Configuration{AccessToken: "my-access-token", Space: cf.Space{Name: "my-space", Guid: "my-space-guid"}}
+	cache := &testconfig.FakeCompletionCache{}
+	cache.Save("apps", "my-space-guid", []string{"cached-app"})
+	spaceRepo := &testapi.FakeSpaceRepository{SummarySpace: cf.Space{Applications: []cf.Application{{Name: "my-app"}}}}
+
+	ui := callCompletion([]string{"names", "apps"}, config, cache, &testapi.FakeOrgRepository{}, spaceRepo)
+
+	assert.Equal(t, ui.Outputs, []string{"cached-app"})
+}
+
+func TestCompletionNamesListedWhenTheCacheIsForAnotherSpace(t *testing.T) {
+	config := &configuration.Configuration{AccessToken: "my-access-token", Space: cf.Space{Name: "my-space", Guid: "my-space-guid"}}
+	cache := &testconfig.FakeCompletionCache{}
+	cache.Save("apps", "other-space-guid", []string{"cached-app"})
+	spaceRepo := &testapi.FakeSpaceRepository{SummarySpace: cf.Space{
+		Applications:     []cf.Application{{Name: "app-1"}, {Name: "app-2"}},
+		ServiceInstances: []cf.ServiceInstance{{Name: "my-service"}},
+	}}
+
+	ui := callCompletion([]string{"names", "apps"}, config, cache, &testapi.FakeOrgRepository{}, spaceRepo)
+
+	assert.Equal(t, ui.Outputs, []string{"app-1", "app-2"})
+}
+
+func TestCompletionNamesForOrgsAndSpaces(t *testing.T) {
+	config := &configuration.Configuration{AccessToken: "my-access-token", Organization: cf.Organization{Name: "my-org", Guid: "my-org-guid"}}
+	orgRepo := &testapi.FakeOrgRepository{Organizations: []cf.Organization{{Name: "org-1"}, {Name: "org-2"}}}
+	spaceRepo := &testapi.FakeSpaceRepository{Spaces: []cf.Space{{Name: "space-1"}}}
+
+	ui := callCompletion([]string{"names", "orgs"}, config, &testconfig.FakeCompletionCache{}, orgRepo, spaceRepo)
+	assert.Equal(t, ui.Outputs, []string{"org-1", "org-2"})
+
+	ui = callCompletion([]string{"names", "spaces"}, config, &testconfig.FakeCompletionCache{}, orgRepo, spaceRepo)
+	assert.Equal(t, ui.Outputs, []string{"space-1"})
+}
+
+func TestCompletionNamesWhenNotLoggedIn(t *testing.T) {
+	orgRepo := &testapi.FakeOrgRepository{Organizations: []cf.Organization{{Name: "org-1"}}}
+
+	ui := callCompletion([]string{"names", "orgs"}, &configuration.Configuration{}, &testconfig.FakeCompletionCache{}, orgRepo, &testapi.FakeSpaceRepository{})
+
+	assert.Equal(t, len(ui.Outputs), 0)
+}
+
+func callCompletion(args []string, config *configuration.Configuration, cache configuration.CompletionCache, orgRepo *testapi.FakeOrgRepository, spaceRepo *testapi.FakeSpaceRepository) (ui *testterm.FakeUI) {
+	ui = new(testterm.FakeUI)
+	cmd := NewCompletion(ui, config, cache, orgRepo, spaceRepo)
+	testcmd.RunCommand(cmd, testcmd.NewContext("completion", args), &testreq.FakeReqFactory{})
+	return
+}
@@ -0,0 +1,332 @@
+package commands
+
+import (
+	"cf"
+	"cf/configuration"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+type Config struct {
+	ui         terminal.UI
+	configRepo configuration.ConfigurationRepository
+}
+
+func NewConfig(ui terminal.UI, configRepo configuration.ConfigurationRepository) (cmd Config) {
+	cmd.ui = ui
+	cmd.configRepo = configRepo
+	return
+}
+
+func (cmd Config) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	args := c.Args()
+
+	validUsage := false
+	if len(args) >= 2 {
+		switch args[0] + " " + args[1] {
+		case "alias list", "default list", "language list", "language unset":
+			validUsage = len(args) == 2
+		case "alias set":
+			validUsage = len(args) >= 4 && len(aliasExpansion(args[3:])) > 0
+		case "alias unset":
+			validUsage = len(args) == 3
+		case "default set":
+			validUsage = len(args) == 5
+		case "default unset":
+			validUsage = len(args) == 4
+		case "language set":
+			validUsage = len(args) == 3
+		}
+	}
+
+	if !validUsage {
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
+		cmd.ui.FailWithUsage(c, "config")
+	}
+	return
+}
+
+func (cmd Config) Run(c *cli.Context) (err error) {
+	config, err := cmd.configRepo.Get()
+	if err != nil {
+		cmd.ui.ConfigFailure(err)
+		return
+	}
+
+	args := c.Args()
+	switch args[0] + " " + args[1] {
+	case "alias list":
+		err = cmd.listAliases(config)
+	case "alias set":
+		err = cmd.setAlias(c.App, config, args[2], aliasExpansion(args[3:]))
+	case "alias unset":
+		err = cmd.unsetAlias(config, args[2])
+	case "default list":
+		err = cmd.listDefaults(config)
+	case "default set":
+		err = cmd.setDefault(c.App, config, args[2], args[3], args[4])
+	case "default unset":
+		err = cmd.unsetDefault(c.App, config, args[2], args[3])
+	case "language list":
+		err = cmd.listLanguages()
+	case "language set":
+		err = cmd.setLanguage(config, args[2])
+	case "language unset":
+		err = cmd.unsetLanguage(config)
+	}
+	return
+}
+
+func (cmd Config) listAliases(config *configuration.Configuration) (err error) {
+	cmd.ui.Status(i18n.T("config.getting_aliases"))
+	cmd.ui.Ok()
+	err = cmd.ui.Render(config.Aliases)
+	if err != nil {
+		return
+	}
+
+	if len(config.Aliases) == 0 {
+		cmd.ui.Say(i18n.T("config.no_aliases_defined"))
+		return
+	}
+
+	table := [][]string{
+		[]string{"alias", "command"},
+	}
+	for _, name := range sortedKeys(config.Aliases) {
+		table = append(table, []string{name, strings.Join(config.Aliases[name], " ")})
+	}
+	return cmd.ui.DisplayTable(table)
+}
+
+// The command of an alias is given quoted when it has flags, as flags on the
+// command line would be parsed as flags of config itself.
+func (cmd Config) setAlias(app *cli.App, config *configuration.Configuration, name string, expansion []string) (err error) {
+	cmd.ui.Status(i18n.T("config.setting_alias"), terminal.EntityNameColor(name), terminal.EntityNameColor(strings.Join(expansion, " ")))
+
+	if app.Command(name) != nil {
+		err = cf.NewCommandError(cf.UsageError, i18n.T("config.alias_is_a_command"), name)
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	if app.Command(expansion[0]) == nil {
+		err = cf.NewCommandError(cf.UsageError, i18n.T("config.alias_unknown_command"), expansion[0])
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	if config.Aliases == nil {
+		config.Aliases = map[string][]string{}
+	}
+	config.Aliases[name] = expansion
+	return cmd.saveConfig()
+}
+
+// The command of an alias is either given as separate arguments, kept as the
+// shell passed them, or as a single quoted argument, which is split into words
+// the way a shell would, so that quotes inside it group words.
+func aliasExpansion(args []string) (expansion []string) {
+	if len(args) > 1 {
+		return args
+	}
+
+	var word []rune
+	inWord := false
+	var quote rune
+	for _, char := range args[0] {
+		switch {
+		case quote != 0 && char == quote:
+			quote = 0
+		case quote != 0:
+			word = append(word, char)
+		case char == '"' || char == '\'':
+			quote = char
+			inWord = true
+		case unicode.IsSpace(char):
+			if inWord {
+				expansion = append(expansion, string(word))
+				word, inWord = nil, false
+			}
+		default:
+			word = append(word, char)
+			inWord = true
+		}
+	}
+	if inWord {
+		expansion = append(expansion, string(word))
+	}
+	return
+}
+
+func (cmd Config) unsetAlias(config *configuration.Configuration, name string) (err error) {
+	cmd.ui.Status(i18n.T("config.removing_alias"), terminal.EntityNameColor(name))
+
+	if _, found := config.Aliases[name]; !found {
+		cmd.ui.Ok()
+		cmd.ui.Warn(i18n.T("config.alias_not_found"), name)
+		return
+	}
+
+	delete(config.Aliases, name)
+	return cmd.saveConfig()
+}
+
+func (cmd Config) listDefaults(config *configuration.Configuration) (err error) {
+	cmd.ui.Status(i18n.T("config.getting_defaults"))
+	cmd.ui.Ok()
+	err = cmd.ui.Render(config.Defaults)
+	if err != nil {
+		return
+	}
+
+	if len(config.Defaults) == 0 {
+		cmd.ui.Say(i18n.T("config.no_defaults"))
+		return
+	}
+
+	table := [][]string{
+		[]string{"command", "flag", "value"},
+	}
+	for _, cmdName := range sortedKeys(config.Defaults) {
+		for _, flag := range sortedKeys(config.Defaults[cmdName]) {
+			table = append(table, []string{cmdName, "-" + flag, config.Defaults[cmdName][flag]})
+		}
+	}
+	return cmd.ui.DisplayTable(table)
+}
+
+func (cmd Config) setDefault(app *cli.App, config *configuration.Configuration, cmdName, flag, value string) (err error) {
+	flag = strings.TrimLeft(flag, "-")
+	cmd.ui.Status(i18n.T("config.setting_default"), terminal.EntityNameColor(cmdName), flag, terminal.EntityNameColor(value))
+
+	command, err := cmd.findCommandFlag(app, cmdName, flag)
+	if err != nil {
+		return
+	}
+
+	if config.Defaults == nil {
+		config.Defaults = map[string]map[string]string{}
+	}
+	if config.Defaults[command.Name] == nil {
+		config.Defaults[command.Name] = map[string]string{}
+	}
+	config.Defaults[command.Name][flag] = value
+	return cmd.saveConfig()
+}
+
+func (cmd Config) unsetDefault(app *cli.App, config *configuration.Configuration, cmdName, flag string) (err error) {
+	flag = strings.TrimLeft(flag, "-")
+	cmd.ui.Status(i18n.T("config.removing_default"), terminal.EntityNameColor(cmdName), flag)
+
+	if command := app.Command(cmdName); command != nil {
+		cmdName = command.Name
+	}
+
+	if _, found := config.Defaults[cmdName][flag]; !found {
+		cmd.ui.Ok()
+		cmd.ui.Warn(i18n.T("config.default_not_found"), cmdName, flag)
+		return
+	}
+
+	delete(config.Defaults[cmdName], flag)
+	if len(config.Defaults[cmdName]) == 0 {
+		delete(config.Defaults, cmdName)
+	}
+	return cmd.saveConfig()
+}
+
+func (cmd Config) listLanguages() (err error) {
+	cmd.ui.Status(i18n.T("config.getting_languages"))
+	cmd.ui.Ok()
+	err = cmd.ui.Render(i18n.Languages())
+	if err != nil {
+		return
+	}
+
+	for _, lang := range i18n.Languages() {
+		if lang == i18n.Language() {
+			cmd.ui.Say(i18n.T("config.current_language"), terminal.EntityNameColor(lang))
+		} else {
+			cmd.ui.Say(lang)
+		}
+	}
+	return
+}
+
+func (cmd Config) setLanguage(config *configuration.Configuration, lang string) (err error) {
+	cmd.ui.Status(i18n.T("config.setting_language"), terminal.EntityNameColor(lang))
+
+	if !i18n.IsLanguage(lang) {
+		err = cf.NewCommandError(cf.UsageError, i18n.T("config.unknown_language"), lang, strings.Join(i18n.Languages(), ", "))
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	config.Language = lang
+	return cmd.saveConfig()
+}
+
+// Without a language in the config, the language of the locale is used.
+func (cmd Config) unsetLanguage(config *configuration.Configuration) (err error) {
+	cmd.ui.Status(i18n.T("config.removing_language"))
+
+	config.Language = ""
+	return cmd.saveConfig()
+}
+
+// Defaults can only be set for flags the command has.
+func (cmd Config) findCommandFlag(app *cli.App, cmdName, flag string) (command *cli.Command, err error) {
+	command = app.Command(cmdName)
+	if command == nil {
+		err = cf.NewCommandError(cf.UsageError, i18n.T("config.unknown_command"), cmdName)
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	for _, commandFlag := range command.Flags {
+		if name, _ := completionFlagName(commandFlag); strings.TrimLeft(name, "-") == flag {
+			return
+		}
+	}
+
+	err = cf.NewCommandError(cf.UsageError, i18n.T("config.unknown_flag"), command.Name, flag)
+	cmd.ui.Failed(err.Error())
+	return
+}
+
+func (cmd Config) saveConfig() (err error) {
+	err = cmd.configRepo.Save()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+	return
+}
+
+func sortedKeys(data interface{}) (keys []string) {
+	switch data := data.(type) {
+	case map[string][]string:
+		for key := range data {
+			keys = append(keys, key)
+		}
+	case map[string]map[string]string:
+		for key := range data {
+			keys = append(keys, key)
+		}
+	case map[string]string:
+		for key := range data {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return
+}
@@ -0,0 +1,149 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+	"testing"
+)
+
+func TestConfigFailsWithUsage(t *testing.T) {
+	ui := callConfig([]string{})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callConfig([]string{"alias"})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callConfig([]string{"alias", "set", "deploy"})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callConfig([]string{"default", "set", "push", "m"})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callConfig([]string{"language", "set"})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callConfig([]string{"alias", "list"})
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestConfigSetsAnAlias(t *testing.T) {
+	configRepo := resetConfigForConfigTest()
+
+	ui := callConfig([]string{"alias", "set", "deploy", "push --no-route"})
+
+	assert.Contains(t, ui.Outputs[0], "Setting alias deploy to push --no-route")
+	assert.Contains(t, ui.Outputs[1], "OK")
+
+	config, _ := configRepo.Get()
+	assert.Equal(t, testconfig.SavedConfiguration.Aliases["deploy"], []string{"push", "--no-route"})
+	assert.Equal(t, config.Aliases["deploy"], []string{"push", "--no-route"})
+}
+
+func TestConfigKeepsQuotedArgumentsOfAnAlias(t *testing.T) {
+	resetConfigForConfigTest()
+
+	callConfig([]string{"alias", "set", "team-space", "create-space", "team space"})
+	assert.Equal(t, testconfig.SavedConfiguration.Aliases["team-space"], []string{"create-space", "team space"})
+
+	callConfig([]string{"alias", "set", "deploy", `push -c "bundle exec rackup"`})
+	assert.Equal(t, testconfig.SavedConfiguration.Aliases["deploy"], []string{"push", "-c", "bundle exec rackup"})
+
+	ui := callConfig([]string{"alias", "set", "deploy", " "})
+	assert.True(t, ui.FailedWithUsage)
+}
+
+func TestConfigRefusesAliasesThatAreNotUsable(t *testing.T) {
+	resetConfigForConfigTest()
+
+	ui := callConfig([]string{"alias", "set", "push", "apps"})
+	assert.Contains(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "push is already a command")
+
+	ui = callConfig([]string{"alias", "set", "deploy", "deploy-app"})
+	assert.Contains(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "Unknown command deploy-app")
+	assert.Nil(t, testconfig.SavedConfiguration.Aliases)
+}
+
+func TestConfigListsAndUnsetsAliases(t *testing.T) {
+	configRepo := resetConfigForConfigTest()
+	config, _ := configRepo.Get()
+	config.Aliases = map[string][]string{
+		"l":      []string{"logs", "--recent"},
+		"deploy": []string{"push", "--no-route"},
+	}
+
+	ui := callConfig([]string{"alias", "list"})
+	assert.Contains(t, ui.Outputs[2], "alias")
+	assert.Contains(t, ui.Outputs[3], "deploy")
+	assert.Contains(t, ui.Outputs[3], "push --no-route")
+	assert.Contains(t, ui.Outputs[4], "logs --recent")
+
+	ui = callConfig([]string{"alias", "unset", "l"})
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, len(testconfig.SavedConfiguration.Aliases), 1)
+
+	ui = callConfig([]string{"alias", "unset", "l"})
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[2], "Alias l does not exist")
+}
+
+func TestConfigSetsADefaultFlagValue(t *testing.T) {
+	resetConfigForConfigTest()
+
+	ui := callConfig([]string{"default", "set", "p", "m", "512M"})
+	assert.Contains(t, ui.Outputs[0], "Setting default for p -m to 512M")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, testconfig.SavedConfiguration.Defaults["push"], map[string]string{"m": "512M"})
+
+	ui = callConfig([]string{"default", "set", "push", "memory", "512M"})
+	assert.Contains(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "The push command has no -memory flag")
+
+	ui = callConfig([]string{"default", "unset", "p", "m"})
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Nil(t, testconfig.SavedConfiguration.Defaults["push"])
+}
+
+func TestConfigSetsTheLanguage(t *testing.T) {
+	resetConfigForConfigTest()
+
+	ui := callConfig([]string{"language", "set", "fr"})
+	assert.Contains(t, ui.Outputs[0], "Setting language to fr")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, testconfig.SavedConfiguration.Language, "fr")
+
+	ui = callConfig([]string{"language", "set", "xx"})
+	assert.Contains(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "Unknown language xx. Use one of: en, fr")
+	assert.Equal(t, testconfig.SavedConfiguration.Language, "fr")
+
+	ui = callConfig([]string{"language", "unset"})
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, testconfig.SavedConfiguration.Language, "")
+}
+
+func TestConfigListsLanguages(t *testing.T) {
+	ui := callConfig([]string{"language", "list"})
+
+	assert.Contains(t, ui.Outputs[0], "Getting languages")
+	assert.Contains(t, ui.Outputs[2], "en (current)")
+	assert.Equal(t, ui.Outputs[3], "fr")
+}
+
+func resetConfigForConfigTest() testconfig.FakeConfigRepository {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+	return configRepo
+}
+
+func callConfig(args []string) (ui *testterm.FakeUI) {
+	ui = new(testterm.FakeUI)
+	cmd := NewConfig(ui, testconfig.FakeConfigRepository{})
+	testcmd.RunCommand(cmd, testcmd.NewContext("config", args), &testreq.FakeReqFactory{})
+	return
+}
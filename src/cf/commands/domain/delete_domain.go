@@ -2,6 +2,7 @@ package domain
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -21,7 +22,7 @@ func NewDeleteDomain(ui terminal.UI, repo api.DomainRepository) (cmd *DeleteDoma
 
 func (cmd *DeleteDomain) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 1 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "delete-domain")
 		return
 	}
@@ -37,16 +38,16 @@ func (cmd *DeleteDomain) GetRequirements(reqFactory requirements.Factory, c *cli
 	return
 }
 
-func (cmd *DeleteDomain) Run(c *cli.Context) {
+func (cmd *DeleteDomain) Run(c *cli.Context) (err error) {
 	domainName := c.Args()[0]
 	force := c.Bool("f")
 
-	cmd.ui.Say("Deleting domain %s...", domainName)
+	cmd.ui.Status(i18n.T("delete_domain.deleting_domain"), domainName)
 
 	domain, apiResponse := cmd.domainRepo.FindByNameInOrg(domainName, cmd.orgReq.GetOrganization())
 	if apiResponse.IsError() {
-		cmd.ui.Failed("Error finding domain %s\n%s", domainName, apiResponse.Message)
-		return
+		cmd.ui.Failed(i18n.T("delete_domain.error_finding_domain"), domainName, apiResponse.Message)
+		return apiResponse.AsError()
 	}
 	if apiResponse.IsNotFound() {
 		cmd.ui.Ok()
@@ -57,9 +58,9 @@ func (cmd *DeleteDomain) Run(c *cli.Context) {
 	if !force {
 		var answer bool
 		if domain.Shared {
-			answer = cmd.ui.Confirm("This domain is shared across all orgs.\nDeleting it will remove all associated routes, and will make any app with this domain unreachable.\nAre you sure you want to delete the domain %s? ", domainName)
+			answer = cmd.ui.Confirm(i18n.T("delete_domain.confirm_shared"), domainName)
 		} else {
-			answer = cmd.ui.Confirm("Are you sure you want to delete the domain %s and all of its associations?", domainName)
+			answer = cmd.ui.Confirm(i18n.T("delete_domain.confirm"), domainName)
 		}
 
 		if !answer {
@@ -69,9 +70,10 @@ func (cmd *DeleteDomain) Run(c *cli.Context) {
 
 	apiResponse = cmd.domainRepo.DeleteDomain(domain)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Error deleting domain %s\n%s", domainName, apiResponse.Message)
-		return
+		cmd.ui.Failed(i18n.T("delete_domain.error_deleting_domain"), domainName, apiResponse.Message)
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -3,6 +3,7 @@ package domain
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/net"
 	"cf/requirements"
 	"cf/terminal"
@@ -29,7 +30,7 @@ func NewDomainMapper(ui terminal.UI, domainRepo api.DomainRepository, bind bool)
 
 func (cmd *DomainMapper) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		if cmd.bind {
 			cmd.ui.FailWithUsage(c, "map-domain")
 		} else {
@@ -53,7 +54,7 @@ func (cmd *DomainMapper) GetRequirements(reqFactory requirements.Factory, c *cli
 	return
 }
 
-func (cmd *DomainMapper) Run(c *cli.Context) {
+func (cmd *DomainMapper) Run(c *cli.Context) (err error) {
 	var (
 		apiResponse net.ApiResponse
 		domain      cf.Domain
@@ -64,15 +65,15 @@ func (cmd *DomainMapper) Run(c *cli.Context) {
 	org := cmd.orgReq.GetOrganization()
 
 	if cmd.bind {
-		cmd.ui.Say("Mapping domain %s to space %s...", domainName, space.Name)
+		cmd.ui.Status(i18n.T("domain_mapper.mapping"), domainName, space.Name)
 	} else {
-		cmd.ui.Say("Unmapping domain %s from space %s...", domainName, space.Name)
+		cmd.ui.Status(i18n.T("domain_mapper.unmapping"), domainName, space.Name)
 	}
 
 	domain, apiResponse = cmd.domainRepo.FindByNameInOrg(domainName, org)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Error finding domain %s\n%s", domainName, apiResponse.Message)
-		return
+		cmd.ui.Failed(i18n.T("domain_mapper.error_finding_domain"), domainName, apiResponse.Message)
+		return apiResponse.AsError()
 	}
 
 	if cmd.bind {
@@ -83,7 +84,7 @@ func (cmd *DomainMapper) Run(c *cli.Context) {
 
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
@@ -3,6 +3,7 @@ package domain
 import (
 	"cf/api"
 	"cf/commands/application"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -25,7 +26,7 @@ func NewListDomains(ui terminal.UI, domainRepo api.DomainRepository) (cmd *ListD
 
 func (cmd *ListDomains) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) > 0 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "domains")
 		return
 	}
@@ -38,15 +39,15 @@ func (cmd *ListDomains) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *ListDomains) Run(c *cli.Context) {
+func (cmd *ListDomains) Run(c *cli.Context) (err error) {
 	org := cmd.orgReq.GetOrganization()
 
-	cmd.ui.Say("Getting domains in org %s...", org.Name)
+	cmd.ui.Status(i18n.T("list_domains.getting_domains"), org.Name)
 
 	domains, apiResponse := cmd.domainRepo.FindAllByOrg(org)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	table := [][]string{
@@ -70,5 +71,9 @@ func (cmd *ListDomains) Run(c *cli.Context) {
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.DisplayTable(table)
+	err = cmd.ui.Render(domains)
+	if err != nil {
+		return
+	}
+	return cmd.ui.DisplayTable(table)
 }
@@ -3,6 +3,7 @@ package domain
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -24,7 +25,7 @@ func NewReserveDomain(ui terminal.UI, domainRepo api.DomainRepository) (cmd *Res
 
 func (cmd *ReserveDomain) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "reserve-domain")
 		return
 	}
@@ -37,20 +38,21 @@ func (cmd *ReserveDomain) GetRequirements(reqFactory requirements.Factory, c *cl
 	return
 }
 
-func (cmd *ReserveDomain) Run(c *cli.Context) {
+func (cmd *ReserveDomain) Run(c *cli.Context) (err error) {
 	domainName := c.Args()[1]
 	owningOrg := cmd.orgReq.GetOrganization()
 
-	cmd.ui.Say("Reserving domain %s for org %s...", domainName, owningOrg.Name)
+	cmd.ui.Status(i18n.T("reserve_domain.reserving"), domainName, owningOrg.Name)
 
 	domain := cf.Domain{Name: domainName}
 
 	_, apiResponse := cmd.domainRepo.Create(domain, owningOrg)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.Say("TIP: Use '%s map-domain' to assign it to a space", cf.Name)
+	cmd.ui.Status(i18n.T("reserve_domain.map_domain_tip"), cf.Name)
+	return
 }
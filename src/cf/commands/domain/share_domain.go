@@ -3,6 +3,7 @@ package domain
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -24,7 +25,7 @@ func NewShareDomain(ui terminal.UI, domainRepo api.DomainRepository) (cmd *Share
 
 func (cmd *ShareDomain) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 1 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "share-domain")
 		return
 	}
@@ -35,18 +36,19 @@ func (cmd *ShareDomain) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *ShareDomain) Run(c *cli.Context) {
+func (cmd *ShareDomain) Run(c *cli.Context) (err error) {
 	domainName := c.Args()[0]
 
-	cmd.ui.Say("Sharing domain %s...", domainName)
+	cmd.ui.Status(i18n.T("share_domain.sharing"), domainName)
 
 	domain := cf.Domain{Name: domainName}
 
 	apiResponse := cmd.domainRepo.Share(domain)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
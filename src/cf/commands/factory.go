@@ -12,6 +12,7 @@ import (
 	"cf/commands/space"
 	"cf/commands/user"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/terminal"
 	"errors"
 )
@@ -30,7 +31,10 @@ func NewFactory(ui terminal.UI, config *configuration.Configuration, configRepo
 	factory.cmdsByName["api"] = NewApi(ui, config, repoLocator.GetEndpointRepository())
 	factory.cmdsByName["app"] = application.NewShowApp(ui, repoLocator.GetAppSummaryRepository())
 	factory.cmdsByName["apps"] = application.NewListApps(ui, repoLocator.GetSpaceRepository())
+	factory.cmdsByName["auth"] = NewAuthenticate(ui, configRepo, repoLocator.GetAuthenticationRepository())
 	factory.cmdsByName["bind-service"] = service.NewBindService(ui, repoLocator.GetServiceRepository())
+	factory.cmdsByName["completion"] = NewCompletion(ui, config, repoLocator.GetCompletionCache(), repoLocator.GetOrganizationRepository(), repoLocator.GetSpaceRepository())
+	factory.cmdsByName["config"] = NewConfig(ui, configRepo)
 	factory.cmdsByName["create-org"] = organization.NewCreateOrg(ui, repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["create-service"] = service.NewCreateService(ui, repoLocator.GetServiceRepository())
 	factory.cmdsByName["create-service-auth-token"] = serviceauthtoken.NewCreateServiceAuthToken(ui, repoLocator.GetServiceAuthTokenRepository())
@@ -50,15 +54,17 @@ func NewFactory(ui terminal.UI, config *configuration.Configuration, configRepo
 	factory.cmdsByName["env"] = application.NewEnv(ui)
 	factory.cmdsByName["events"] = application.NewEvents(ui, repoLocator.GetAppEventsRepository())
 	factory.cmdsByName["files"] = application.NewFiles(ui, repoLocator.GetAppFilesRepository())
-	factory.cmdsByName["login"] = NewLogin(ui, configRepo, repoLocator.GetAuthenticationRepository())
+	factory.cmdsByName["login"] = NewLogin(ui, configRepo, repoLocator.GetAuthenticationRepository(), repoLocator.GetOrganizationRepository(), repoLocator.GetSpaceRepository())
 	factory.cmdsByName["logout"] = NewLogout(ui, configRepo)
 	factory.cmdsByName["logs"] = application.NewLogs(ui, repoLocator.GetLogsRepository())
 	factory.cmdsByName["marketplace"] = service.NewMarketplaceServices(ui, repoLocator.GetServiceRepository())
 	factory.cmdsByName["map-domain"] = domain.NewDomainMapper(ui, repoLocator.GetDomainRepository(), true)
 	factory.cmdsByName["map-route"] = route.NewRouteMapper(ui, repoLocator.GetRouteRepository(), true)
+	factory.cmdsByName["oauth-token"] = NewOAuthToken(ui, repoLocator.GetAuthenticationRepository())
 	factory.cmdsByName["org"] = organization.NewShowOrg(ui)
 	factory.cmdsByName["orgs"] = organization.NewListOrgs(ui, repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["password"] = NewPassword(ui, repoLocator.GetPasswordRepository(), configRepo)
+	factory.cmdsByName["profile"] = NewProfile(ui, configRepo, configuration.NewProfileDiskRepository())
 	factory.cmdsByName["rename"] = application.NewRenameApp(ui, repoLocator.GetApplicationRepository())
 	factory.cmdsByName["rename-org"] = organization.NewRenameOrg(ui, repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["rename-service"] = service.NewRenameService(ui, repoLocator.GetServiceRepository())
@@ -89,6 +95,7 @@ func NewFactory(ui terminal.UI, config *configuration.Configuration, configRepo
 	factory.cmdsByName["update-service-broker"] = servicebroker.NewUpdateServiceBroker(ui, repoLocator.GetServiceBrokerRepository())
 	factory.cmdsByName["update-service-auth-token"] = serviceauthtoken.NewUpdateServiceAuthToken(ui, repoLocator.GetServiceAuthTokenRepository())
 	factory.cmdsByName["update-user-provided-service"] = service.NewUpdateUserProvidedService(ui, repoLocator.GetServiceRepository())
+	factory.cmdsByName["whoami"] = NewWhoami(ui, config)
 
 	start := application.NewStart(ui, config, repoLocator.GetApplicationRepository())
 	stop := application.NewStop(ui, repoLocator.GetApplicationRepository())
@@ -106,7 +113,7 @@ func NewFactory(ui terminal.UI, config *configuration.Configuration, configRepo
 func (f ConcreteFactory) GetByCmdName(cmdName string) (cmd Command, err error) {
 	cmd, found := f.cmdsByName[cmdName]
 	if !found {
-		err = errors.New("Command not found")
+		err = errors.New(i18n.T("factory.command_not_found"))
 	}
 	return
 }
@@ -4,10 +4,13 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/net"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
+	"os"
+	"sort"
 )
 
 const maxLoginTries = 3
@@ -17,13 +20,22 @@ type Login struct {
 	config        *configuration.Configuration
 	configRepo    configuration.ConfigurationRepository
 	authenticator api.AuthenticationRepository
+	orgRepo       api.OrganizationRepository
+	spaceRepo     api.SpaceRepository
 }
 
-func NewLogin(ui terminal.UI, configRepo configuration.ConfigurationRepository, authenticator api.AuthenticationRepository) (cmd Login) {
+func NewLogin(ui terminal.UI,
+	configRepo configuration.ConfigurationRepository,
+	authenticator api.AuthenticationRepository,
+	orgRepo api.OrganizationRepository,
+	spaceRepo api.SpaceRepository) (cmd Login) {
+
 	cmd.ui = ui
 	cmd.configRepo = configRepo
 	cmd.config, _ = configRepo.Get()
 	cmd.authenticator = authenticator
+	cmd.orgRepo = orgRepo
+	cmd.spaceRepo = spaceRepo
 	return
 }
 
@@ -31,52 +43,260 @@ func (cmd Login) GetRequirements(reqFactory requirements.Factory, c *cli.Context
 	return
 }
 
-func (cmd Login) Run(c *cli.Context) {
-	cmd.ui.Say("API endpoint: %s", terminal.EntityNameColor(cmd.config.Target))
-
-	var (
-		username string
-		password string
-	)
+func (cmd Login) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("login.api_endpoint"), terminal.EntityNameColor(cmd.config.Target))
 
-	if len(c.Args()) > 0 {
-		username = c.Args()[0]
+	var apiResponse net.ApiResponse
+	if c.Bool("sso") {
+		apiResponse = cmd.authenticateWithSso()
 	} else {
-		username = cmd.ui.Ask("Username%s", terminal.PromptColor(">"))
+		apiResponse = cmd.authenticateWithPassword(c)
+	}
+
+	// Failures have already been reported while authenticating
+	if apiResponse.IsNotSuccessful() {
+		return cf.NewCommandError(cf.AuthError, "%s", apiResponse.Message)
 	}
 
-	if len(c.Args()) > 1 {
-		password = c.Args()[1]
-		cmd.ui.Say("Authenticating...")
+	cmd.ui.Ok()
 
-		apiResponse := cmd.doLogin(username, password)
-		if apiResponse.IsNotSuccessful() {
+	orgName := c.String("o")
+	spaceName := c.String("s")
+	if orgName == "" && spaceName == "" {
+		return cmd.selectOrganizationAndSpace()
+	}
+
+	return cmd.setOrganizationAndSpace(orgName, spaceName)
+}
+
+func (cmd Login) authenticateWithPassword(c *cli.Context) (apiResponse net.ApiResponse) {
+	// Login servers that do not advertise their prompts still take a username and password
+	prompts, apiResponse := cmd.authenticator.GetLoginPrompts()
+	if apiResponse.IsNotSuccessful() {
+		prompts = defaultLoginPrompts()
+	}
+
+	// The passcode prompt is only used for single sign-on logins
+	passwordPrompts := map[string]cf.AuthPrompt{}
+	for key, prompt := range prompts {
+		if key != "passcode" {
+			passwordPrompts[key] = prompt
+		}
+	}
+
+	credentials := map[string]string{}
+	if username := firstNonEmpty(c.String("u"), argAt(c, 0), os.Getenv("CF_USERNAME")); username != "" {
+		credentials["username"] = username
+	}
+	if password := firstNonEmpty(c.String("p"), argAt(c, 1), os.Getenv("CF_PASSWORD")); password != "" {
+		credentials["password"] = password
+	}
+
+	return cmd.promptAndAuthenticate(passwordPrompts, credentials, cmd.authenticator.Authenticate)
+}
+
+func (cmd Login) authenticateWithSso() (apiResponse net.ApiResponse) {
+	prompts, apiResponse := cmd.authenticator.GetLoginPrompts()
+	if apiResponse.IsNotSuccessful() {
+		cmd.ui.Failed(i18n.T("login.error_fetching_login"), apiResponse.Message)
+		return
+	}
+
+	passcodePrompt, ok := prompts["passcode"]
+	if !ok {
+		apiResponse = net.NewApiResponseWithMessage("%s", i18n.T("login.sso_not_supported"))
+		cmd.ui.Failed(apiResponse.Message)
+		return
+	}
+
+	cmd.ui.Status(i18n.T("login.one_time_passcode"), terminal.EntityNameColor(cmd.config.AuthorizationEndpoint+"/passcode"))
+
+	passcodePrompt.Type = cf.AuthPromptTypePassword
+	prompts = map[string]cf.AuthPrompt{"passcode": passcodePrompt}
+
+	return cmd.promptAndAuthenticate(prompts, map[string]string{}, func(credentials map[string]string) net.ApiResponse {
+		return cmd.authenticator.AuthenticateWithPasscode(credentials["passcode"])
+	})
+}
+
+func defaultLoginPrompts() map[string]cf.AuthPrompt {
+	return map[string]cf.AuthPrompt{
+		"username": cf.AuthPrompt{Type: cf.AuthPromptTypeText, DisplayName: i18n.T("login.username_prompt")},
+		"password": cf.AuthPrompt{Type: cf.AuthPromptTypePassword, DisplayName: i18n.T("login.password_prompt")},
+	}
+}
+
+// Text prompts are asked once, while password prompts are asked again after each failed attempt.
+// Without a terminal there is nobody to answer a text prompt, so a missing answer fails the login
+// straight away. Passwords can still be piped in.
+func (cmd Login) promptAndAuthenticate(prompts map[string]cf.AuthPrompt, credentials map[string]string, authenticate func(map[string]string) net.ApiResponse) (apiResponse net.ApiResponse) {
+	textKeys, passwordKeys := sortedPromptKeys(prompts)
+
+	for _, key := range textKeys {
+		if credentials[key] != "" {
+			continue
+		}
+
+		if !cmd.ui.IsInteractive() {
+			apiResponse = missingCredentialResponse(key, prompts[key])
 			cmd.ui.Failed(apiResponse.Message)
 			return
 		}
 
-	} else {
-		for i := 0; i < maxLoginTries; i++ {
-			password = cmd.ui.AskForPassword("Password%s", terminal.PromptColor(">"))
-			cmd.ui.Say("Authenticating...")
-
-			apiResponse := cmd.doLogin(username, password)
-			if apiResponse.IsNotSuccessful() {
-				cmd.ui.Failed(apiResponse.Message)
-				continue
+		credentials[key] = cmd.ui.Ask("%s%s", prompts[key].DisplayName, terminal.PromptColor(">"))
+	}
+
+	secretKeys := []string{}
+	for _, key := range passwordKeys {
+		if credentials[key] == "" {
+			secretKeys = append(secretKeys, key)
+		}
+	}
+
+	tries := maxLoginTries
+	if len(secretKeys) == 0 || !cmd.ui.IsInteractive() {
+		tries = 1
+	}
+
+	for i := 0; i < tries; i++ {
+		for _, key := range secretKeys {
+			credentials[key] = cmd.ui.AskForPassword("%s%s", prompts[key].DisplayName, terminal.PromptColor(">"))
+		}
+
+		cmd.ui.Status(i18n.T("login.authenticating"))
+
+		apiResponse = authenticate(credentials)
+		if apiResponse.IsSuccessful() {
+			return
+		}
+
+		cmd.ui.Failed(apiResponse.Message)
+	}
+	return
+}
+
+func missingCredentialResponse(key string, prompt cf.AuthPrompt) net.ApiResponse {
+	if key == "username" {
+		return net.NewApiResponseWithMessage("%s", i18n.T("login.missing_username"))
+	}
+	return net.NewApiResponseWithMessage(i18n.T("login.missing_prompt"), prompt.DisplayName)
+}
+
+func (cmd Login) selectOrganizationAndSpace() (err error) {
+	selector := cmd.targetSelector()
+
+	orgSelected, err := selector.selectOrganization()
+	if err != nil {
+		return
+	}
+	if !orgSelected {
+		cmd.ui.Status(i18n.T("login.view_target_tip"), terminal.CommandColor(cf.Name+" target"))
+		return
+	}
+
+	_, err = selector.selectSpace()
+	if err != nil {
+		return
+	}
+
+	return cmd.saveAndShowConfiguration()
+}
+
+func (cmd Login) setOrganizationAndSpace(orgName, spaceName string) (err error) {
+	if orgName != "" {
+		cmd.ui.Status(i18n.T("login.targeting_org"), terminal.EntityNameColor(orgName))
+
+		org, apiResponse := cmd.orgRepo.FindByName(orgName)
+		if apiResponse.IsNotSuccessful() {
+			cmd.ui.Failed(i18n.T("login.could_not_target_org"), apiResponse.Message)
+			return apiResponse.AsError()
+		}
+
+		cmd.config.Organization = org
+		cmd.config.Space = cf.Space{}
+
+		if spaceName == "" {
+			_, err = cmd.targetSelector().selectSpace()
+			if err != nil {
+				return
 			}
+		}
+	}
 
+	if spaceName != "" {
+		if !cmd.config.HasOrganization() {
+			err = cf.NewCommandError(cf.GeneralError, "%s", i18n.T("login.org_required_for_space"))
+			cmd.ui.Failed(err.Error())
 			return
 		}
+
+		cmd.ui.Status(i18n.T("login.targeting_space"), terminal.EntityNameColor(spaceName))
+
+		space, apiResponse := cmd.spaceRepo.FindByName(spaceName)
+		if apiResponse.IsNotSuccessful() {
+			cmd.ui.Failed(i18n.T("login.could_not_target_space"), spaceName, apiResponse.Message)
+			return apiResponse.AsError()
+		}
+
+		cmd.config.Space = space
+	}
+
+	return cmd.saveAndShowConfiguration()
+}
+
+func (cmd Login) saveAndShowConfiguration() (err error) {
+	err = cmd.configRepo.Save()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
 	}
+
+	cmd.ui.ShowConfiguration(cmd.config)
 	return
 }
 
-func (cmd Login) doLogin(username, password string) (apiResponse net.ApiResponse) {
-	apiResponse = cmd.authenticator.Authenticate(username, password)
-	if apiResponse.IsSuccessful() {
-		cmd.ui.Ok()
-		cmd.ui.Say("Use '%s' to view or set your target org and space", terminal.CommandColor(cf.Name+" target"))
+func (cmd Login) targetSelector() targetSelector {
+	return targetSelector{ui: cmd.ui, config: cmd.config, orgRepo: cmd.orgRepo, spaceRepo: cmd.spaceRepo}
+}
+
+func sortedPromptKeys(prompts map[string]cf.AuthPrompt) (textKeys, passwordKeys []string) {
+	for key, prompt := range prompts {
+		if prompt.Type == cf.AuthPromptTypePassword {
+			passwordKeys = append(passwordKeys, key)
+		} else {
+			textKeys = append(textKeys, key)
+		}
 	}
+
+	sort.Sort(promptKeys(textKeys))
+	sort.Sort(promptKeys(passwordKeys))
 	return
 }
+
+// Sorts prompt keys alphabetically, but always asks for the username first
+type promptKeys []string
+
+func (keys promptKeys) Len() int      { return len(keys) }
+func (keys promptKeys) Swap(i, j int) { keys[i], keys[j] = keys[j], keys[i] }
+func (keys promptKeys) Less(i, j int) bool {
+	if keys[j] == "username" {
+		return false
+	}
+	return keys[i] == "username" || keys[i] < keys[j]
+}
+
+func argAt(c *cli.Context, index int) string {
+	if len(c.Args()) > index {
+		return c.Args()[index]
+	}
+	return ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -1,14 +1,19 @@
 package commands_test
 
 import (
+	"cf"
 	"cf/api"
 	. "cf/commands"
 	"cf/configuration"
+	"cf/net"
 	"cf/terminal"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"os"
 	testapi "testhelpers/api"
 	testcmd "testhelpers/commands"
 	testconfig "testhelpers/configuration"
+	testnet "testhelpers/net"
 	testterm "testhelpers/terminal"
 	"testing"
 )
@@ -30,6 +35,8 @@ func testSuccessfulLogin(t *testing.T, args []string, inputs []string) (ui *test
 		ui,
 		configRepo,
 		auth,
+		&testapi.FakeOrgRepository{},
+		&testapi.FakeSpaceRepository{},
 	)
 
 	savedConfig := testconfig.SavedConfiguration
@@ -80,6 +87,8 @@ func TestUnsuccessfullyLoggingIn(t *testing.T) {
 		ui,
 		configRepo,
 		&testapi.FakeAuthenticationRepository{AuthError: true, ConfigRepo: configRepo},
+		&testapi.FakeOrgRepository{},
+		&testapi.FakeSpaceRepository{},
 	)
 
 	assert.Contains(t, ui.Outputs[0], config.Target)
@@ -106,6 +115,8 @@ func TestUnsuccessfullyLoggingInWithoutInteractivity(t *testing.T) {
 		ui,
 		configRepo,
 		&testapi.FakeAuthenticationRepository{AuthError: true, ConfigRepo: configRepo},
+		&testapi.FakeOrgRepository{},
+		&testapi.FakeSpaceRepository{},
 	)
 
 	assert.Contains(t, ui.Outputs[0], config.Target)
@@ -115,7 +126,374 @@ func TestUnsuccessfullyLoggingInWithoutInteractivity(t *testing.T) {
 	assert.Equal(t, len(ui.Outputs), 4)
 }
 
-func callLogin(args []string, ui terminal.UI, configRepo configuration.ConfigurationRepository, auth api.AuthenticationRepository) {
-	l := NewLogin(ui, configRepo, auth)
+func TestSuccessfullyLoggingInWithFlags(t *testing.T) {
+	testSuccessfulLogin(t, []string{"-u", "user@example.com", "-p", "password"}, []string{})
+}
+
+func TestSuccessfullyLoggingInWithEnvironmentVariables(t *testing.T) {
+	defer setEnv("CF_USERNAME", "user@example.com")()
+	defer setEnv("CF_PASSWORD", "password")()
+
+	testSuccessfulLogin(t, []string{}, []string{})
+}
+
+func TestLoggingInWithFlagsTakesPrecedenceOverEnvironmentVariables(t *testing.T) {
+	defer setEnv("CF_USERNAME", "other@example.com")()
+	defer setEnv("CF_PASSWORD", "other-password")()
+
+	testSuccessfulLogin(t, []string{"-u", "user@example.com", "-p", "password"}, []string{})
+}
+
+func TestUnsuccessfullyLoggingInWithoutATerminal(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := &testterm.FakeUI{NonInteractive: true}
+	ui.Inputs = []string{"bar", "bar", "bar"}
+
+	callLogin(
+		[]string{"-u", "foo@example.com"},
+		ui,
+		configRepo,
+		&testapi.FakeAuthenticationRepository{AuthError: true, ConfigRepo: configRepo},
+		&testapi.FakeOrgRepository{},
+		&testapi.FakeSpaceRepository{},
+	)
+
+	assert.Equal(t, len(ui.PasswordPrompts), 1)
+	assert.Equal(t, ui.Outputs[1], "Authenticating...")
+	assert.Equal(t, ui.Outputs[2], "FAILED")
+	assert.Equal(t, len(ui.Outputs), 4)
+}
+
+func TestLoggingInWithoutATerminalFailsWhenTheUsernameIsMissing(t *testing.T) {
+	defer setEnv("CF_USERNAME", "")()
+
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := &testterm.FakeUI{NonInteractive: true}
+	auth := &testapi.FakeAuthenticationRepository{ConfigRepo: configRepo}
+
+	callLogin([]string{"-p", "password"}, ui, configRepo, auth, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+
+	assert.Empty(t, ui.Prompts)
+	assert.Empty(t, ui.PasswordPrompts)
+	assert.Nil(t, auth.Credentials)
+	assert.Equal(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "Missing username. Use -u USERNAME")
+}
+
+func TestLoggingInWithoutATerminalFailsWhenALoginPromptIsMissing(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := &testterm.FakeUI{NonInteractive: true}
+	auth := &testapi.FakeAuthenticationRepository{
+		ConfigRepo: configRepo,
+		Prompts: map[string]cf.AuthPrompt{
+			"username": cf.AuthPrompt{Type: cf.AuthPromptTypeText, DisplayName: "Email"},
+			"account":  cf.AuthPrompt{Type: cf.AuthPromptTypeText, DisplayName: "Account"},
+			"password": cf.AuthPrompt{Type: cf.AuthPromptTypePassword, DisplayName: "Password"},
+		},
+	}
+
+	callLogin([]string{"-u", "user@example.com", "-p", "password"}, ui, configRepo, auth, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+
+	assert.Empty(t, ui.Prompts)
+	assert.Nil(t, auth.Credentials)
+	assert.Equal(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "No value for Account")
+}
+
+func TestLoggingInFallsBackToUsernameAndPasswordWhenLoginPromptsAreUnavailable(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testterm.FakeUI)
+	ui.Inputs = []string{"user@example.com", "password"}
+	auth := &testapi.FakeAuthenticationRepository{ConfigRepo: configRepo, GetLoginPromptsError: true}
+
+	callLogin([]string{}, ui, configRepo, auth, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+
+	assert.Contains(t, ui.Prompts[0], "Username")
+	assert.Contains(t, ui.PasswordPrompts[0], "Password")
+	assert.Equal(t, auth.Email, "user@example.com")
+	assert.Equal(t, auth.Password, "password")
+	assert.Contains(t, ui.Outputs[2], "OK")
+}
+
+func TestLoggingInAndTargetingAnOrgAndSpace(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testterm.FakeUI)
+	auth := &testapi.FakeAuthenticationRepository{
+		AccessToken: "my_access_token",
+		ConfigRepo:  configRepo,
+	}
+	orgRepo := &testapi.FakeOrgRepository{
+		FindByNameOrganization: cf.Organization{Name: "my-org", Guid: "my-org-guid"},
+	}
+	spaceRepo := &testapi.FakeSpaceRepository{
+		FindByNameSpace: cf.Space{Name: "my-space", Guid: "my-space-guid"},
+	}
+
+	callLogin(
+		[]string{"-u", "user@example.com", "-p", "password", "-o", "my-org", "-s", "my-space"},
+		ui,
+		configRepo,
+		auth,
+		orgRepo,
+		spaceRepo,
+	)
+
+	savedConfig := testconfig.SavedConfiguration
+
+	assert.Equal(t, orgRepo.FindByNameName, "my-org")
+	assert.Equal(t, spaceRepo.FindByNameName, "my-space")
+	assert.Equal(t, savedConfig.AccessToken, "my_access_token")
+	assert.Equal(t, savedConfig.Organization.Guid, "my-org-guid")
+	assert.Equal(t, savedConfig.Space.Guid, "my-space-guid")
+	assert.Contains(t, ui.DumpOutputs(), "org:             my-org")
+	assert.Contains(t, ui.DumpOutputs(), "space:           my-space")
+}
+
+func TestLoggingInAndTargetingAnOrgThatDoesNotExist(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testterm.FakeUI)
+	auth := &testapi.FakeAuthenticationRepository{ConfigRepo: configRepo}
+	orgRepo := &testapi.FakeOrgRepository{FindByNameNotFound: true}
+	spaceRepo := &testapi.FakeSpaceRepository{}
+
+	callLogin(
+		[]string{"-u", "user@example.com", "-p", "password", "-o", "my-org", "-s", "my-space"},
+		ui,
+		configRepo,
+		auth,
+		orgRepo,
+		spaceRepo,
+	)
+
+	assert.Contains(t, ui.DumpOutputs(), "FAILED")
+	assert.Contains(t, ui.DumpOutputs(), "Could not target org.")
+	assert.Equal(t, spaceRepo.FindByNameName, "")
+}
+
+var loginPromptsRequest = testnet.TestRequest{
+	Method: "GET",
+	Path:   "/login",
+	Header: http.Header{"Authorization": {""}},
+	Response: testnet.TestResponse{Status: http.StatusOK, Body: `
+{
+  "prompts": {
+    "username": ["text", "Email"],
+    "password": ["password", "Password"],
+    "passcode": ["password", "One Time Code"]
+  }
+}`},
+}
+
+var passcodeTokenRequest = testnet.TestRequest{
+	Method: "POST",
+	Path:   "/oauth/token",
+	Header: http.Header{"Authorization": {"Basic Y2Y6"}},
+	Matcher: func(request *http.Request) bool {
+		request.ParseForm()
+		return request.Form.Get("grant_type") == "password" && request.Form.Get("passcode") == "my-passcode"
+	},
+	Response: testnet.TestResponse{Status: http.StatusOK, Body: `
+{
+  "access_token": "my_access_token",
+  "token_type": "BEARER",
+  "refresh_token": "my_refresh_token"
+}`},
+}
+
+func TestLoggingInWithSsoPasscode(t *testing.T) {
+	ts, handler := testnet.NewServer(t, []testnet.TestRequest{loginPromptsRequest, passcodeTokenRequest})
+	defer ts.Close()
+
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+	config, _ := configRepo.Get()
+	config.AuthorizationEndpoint = ts.URL
+
+	ui := new(testterm.FakeUI)
+	ui.Inputs = []string{"my-passcode"}
+	auth := api.NewUAAAuthenticationRepository(net.NewUAAGateway(), configRepo)
+
+	callLogin([]string{"--sso"}, ui, configRepo, auth, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+
+	savedConfig := testconfig.SavedConfiguration
+
+	assert.True(t, handler.AllRequestsCalled())
+	assert.Contains(t, ui.Outputs[1], ts.URL+"/passcode")
+	assert.Contains(t, ui.PasswordPrompts[0], "One Time Code")
+	assert.Contains(t, ui.Outputs[3], "OK")
+	assert.Equal(t, savedConfig.AccessToken, "BEARER my_access_token")
+	assert.Equal(t, savedConfig.RefreshToken, "my_refresh_token")
+}
+
+func TestLoggingInAsksForEachLoginPrompt(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testterm.FakeUI)
+	ui.Inputs = []string{"my-username", "my-account", "123456", "password"}
+	auth := &testapi.FakeAuthenticationRepository{
+		ConfigRepo: configRepo,
+		Prompts: map[string]cf.AuthPrompt{
+			"username": cf.AuthPrompt{Type: cf.AuthPromptTypeText, DisplayName: "Username"},
+			"account":  cf.AuthPrompt{Type: cf.AuthPromptTypeText, DisplayName: "Account"},
+			"password": cf.AuthPrompt{Type: cf.AuthPromptTypePassword, DisplayName: "Password"},
+			"mfaCode":  cf.AuthPrompt{Type: cf.AuthPromptTypePassword, DisplayName: "MFA Code"},
+			"passcode": cf.AuthPrompt{Type: cf.AuthPromptTypePassword, DisplayName: "One Time Code"},
+		},
+	}
+
+	callLogin([]string{}, ui, configRepo, auth, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+
+	assert.Equal(t, len(ui.Prompts), 2)
+	assert.Contains(t, ui.Prompts[0], "Username")
+	assert.Contains(t, ui.Prompts[1], "Account")
+	assert.Equal(t, len(ui.PasswordPrompts), 2)
+	assert.Contains(t, ui.PasswordPrompts[0], "MFA Code")
+	assert.Contains(t, ui.PasswordPrompts[1], "Password")
+	assert.Equal(t, auth.Credentials, map[string]string{
+		"username": "my-username",
+		"account":  "my-account",
+		"mfaCode":  "123456",
+		"password": "password",
+	})
+	assert.Contains(t, ui.Outputs[2], "OK")
+}
+
+func TestLoggingInOnlyAsksForPromptsThatWereNotGiven(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testterm.FakeUI)
+	ui.Inputs = []string{"123456"}
+	auth := &testapi.FakeAuthenticationRepository{
+		ConfigRepo: configRepo,
+		Prompts: map[string]cf.AuthPrompt{
+			"username": cf.AuthPrompt{Type: cf.AuthPromptTypeText, DisplayName: "Email"},
+			"password": cf.AuthPrompt{Type: cf.AuthPromptTypePassword, DisplayName: "Password"},
+			"mfaCode":  cf.AuthPrompt{Type: cf.AuthPromptTypePassword, DisplayName: "MFA Code"},
+		},
+	}
+
+	callLogin([]string{"-u", "user@example.com", "-p", "password"}, ui, configRepo, auth, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+
+	assert.Empty(t, ui.Prompts)
+	assert.Equal(t, len(ui.PasswordPrompts), 1)
+	assert.Contains(t, ui.PasswordPrompts[0], "MFA Code")
+	assert.Equal(t, auth.Credentials, map[string]string{
+		"username": "user@example.com",
+		"password": "password",
+		"mfaCode":  "123456",
+	})
+}
+
+func TestLoggingInWithSsoWhenTheLoginServerDoesNotSupportIt(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testterm.FakeUI)
+	auth := &testapi.FakeAuthenticationRepository{
+		ConfigRepo: configRepo,
+		Prompts: map[string]cf.AuthPrompt{
+			"username": cf.AuthPrompt{Type: cf.AuthPromptTypeText, DisplayName: "Email"},
+			"password": cf.AuthPrompt{Type: cf.AuthPromptTypePassword, DisplayName: "Password"},
+		},
+	}
+
+	callLogin([]string{"--sso"}, ui, configRepo, auth, &testapi.FakeOrgRepository{}, &testapi.FakeSpaceRepository{})
+
+	assert.Equal(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "does not support single sign-on")
+	assert.Empty(t, auth.Passcode)
+	assert.Empty(t, ui.PasswordPrompts)
+}
+
+func TestLoggingInOffersOrgsAndSpacesToTarget(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testterm.FakeUI)
+	ui.Inputs = []string{"other-org", "1"}
+	auth := &testapi.FakeAuthenticationRepository{AccessToken: "my_access_token", ConfigRepo: configRepo}
+	orgRepo := &testapi.FakeOrgRepository{Organizations: []cf.Organization{
+		cf.Organization{Name: "my-org", Guid: "my-org-guid"},
+		cf.Organization{Name: "other-org", Guid: "other-org-guid"},
+	}}
+	spaceRepo := &testapi.FakeSpaceRepository{Spaces: []cf.Space{
+		cf.Space{Name: "my-space", Guid: "my-space-guid"},
+		cf.Space{Name: "other-space", Guid: "other-space-guid"},
+	}}
+
+	callLogin([]string{"-u", "user@example.com", "-p", "password"}, ui, configRepo, auth, orgRepo, spaceRepo)
+
+	assert.Contains(t, ui.DumpOutputs(), "1. my-org")
+	assert.Contains(t, ui.DumpOutputs(), "2. other-org")
+	assert.Contains(t, ui.DumpOutputs(), "1. my-space")
+
+	savedConfig := testconfig.SavedConfiguration
+	assert.Equal(t, savedConfig.Organization.Guid, "other-org-guid")
+	assert.Equal(t, savedConfig.Space.Guid, "my-space-guid")
+}
+
+func TestLoggingInTargetsTheOnlyOrgAndSpace(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testterm.FakeUI)
+	auth := &testapi.FakeAuthenticationRepository{AccessToken: "my_access_token", ConfigRepo: configRepo}
+	orgRepo := &testapi.FakeOrgRepository{Organizations: []cf.Organization{
+		cf.Organization{Name: "my-org", Guid: "my-org-guid"},
+	}}
+	spaceRepo := &testapi.FakeSpaceRepository{Spaces: []cf.Space{
+		cf.Space{Name: "my-space", Guid: "my-space-guid"},
+	}}
+
+	callLogin([]string{"-u", "user@example.com", "-p", "password"}, ui, configRepo, auth, orgRepo, spaceRepo)
+
+	assert.Equal(t, len(ui.Prompts), 0)
+
+	savedConfig := testconfig.SavedConfiguration
+	assert.Equal(t, savedConfig.Organization.Guid, "my-org-guid")
+	assert.Equal(t, savedConfig.Space.Guid, "my-space-guid")
+	assert.Contains(t, ui.DumpOutputs(), "org:             my-org")
+}
+
+func TestLoggingInWithoutATerminalDoesNotAskForAnOrg(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := &testterm.FakeUI{NonInteractive: true}
+	auth := &testapi.FakeAuthenticationRepository{AccessToken: "my_access_token", ConfigRepo: configRepo}
+	orgRepo := &testapi.FakeOrgRepository{Organizations: []cf.Organization{
+		cf.Organization{Name: "my-org", Guid: "my-org-guid"},
+		cf.Organization{Name: "other-org", Guid: "other-org-guid"},
+	}}
+
+	callLogin([]string{"-u", "user@example.com", "-p", "password"}, ui, configRepo, auth, orgRepo, &testapi.FakeSpaceRepository{})
+
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Equal(t, testconfig.SavedConfiguration.Organization.Guid, "")
+	assert.Contains(t, ui.DumpOutputs(), "target")
+}
+
+func setEnv(name, value string) (reset func()) {
+	oldValue := os.Getenv(name)
+	os.Setenv(name, value)
+	return func() { os.Setenv(name, oldValue) }
+}
+
+func callLogin(args []string, ui terminal.UI, configRepo configuration.ConfigurationRepository, auth api.AuthenticationRepository, orgRepo api.OrganizationRepository, spaceRepo api.SpaceRepository) {
+	l := NewLogin(ui, configRepo, auth, orgRepo, spaceRepo)
 	l.Run(testcmd.NewContext("login", args))
 }
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -22,14 +23,15 @@ func (cmd Logout) GetRequirements(reqFactory requirements.Factory, c *cli.Contex
 	return
 }
 
-func (cmd Logout) Run(c *cli.Context) {
-	cmd.ui.Say("Logging out...")
-	err := cmd.configRepo.ClearSession()
+func (cmd Logout) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("logout.logging_out"))
+	err = cmd.configRepo.ClearSession()
 
 	if err != nil {
 		cmd.ui.Failed(err.Error())
-		return
+		return err
 	}
 
 	cmd.ui.Ok()
+	return
 }
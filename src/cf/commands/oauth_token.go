@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type OAuthToken struct {
+	ui            terminal.UI
+	authenticator api.AuthenticationRepository
+}
+
+func NewOAuthToken(ui terminal.UI, authenticator api.AuthenticationRepository) (cmd OAuthToken) {
+	cmd.ui = ui
+	cmd.authenticator = authenticator
+	return
+}
+
+func (cmd OAuthToken) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd OAuthToken) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("oauth_token.getting_oauth_token"))
+
+	token, apiResponse := cmd.authenticator.RefreshAuthToken()
+	if apiResponse.IsNotSuccessful() {
+		cmd.ui.Failed(apiResponse.Message)
+		return apiResponse.AsError()
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Status("")
+	cmd.ui.Say(token)
+
+	info, err := configuration.NewTokenInfo(token)
+	if err != nil {
+		return
+	}
+
+	warnAboutMissingScopes(cmd.ui, info)
+	return
+}
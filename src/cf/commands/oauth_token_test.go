@@ -0,0 +1,54 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	testapi "testhelpers/api"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+	"testing"
+)
+
+func TestOAuthTokenRequirements(t *testing.T) {
+	auth := &testapi.FakeAuthenticationRepository{}
+
+	callOAuthToken(auth, &testreq.FakeReqFactory{LoginSuccess: true})
+	assert.True(t, testcmd.CommandDidPassRequirements)
+
+	callOAuthToken(auth, &testreq.FakeReqFactory{LoginSuccess: false})
+	assert.False(t, testcmd.CommandDidPassRequirements)
+}
+
+func TestOAuthTokenShowsARefreshedToken(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+	config := configRepo.Login()
+	auth := &testapi.FakeAuthenticationRepository{AccessToken: config.AccessToken}
+
+	ui := callOAuthToken(auth, &testreq.FakeReqFactory{LoginSuccess: true})
+
+	assert.True(t, auth.RefreshAuthTokenCalled)
+	assert.Contains(t, ui.Outputs[0], "Getting OAuth token...")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, ui.Outputs[3], config.AccessToken)
+	assert.Contains(t, ui.Outputs[4], "Scope cloud_controller.admin is not granted")
+	assert.Equal(t, len(ui.Outputs), 5)
+}
+
+func TestOAuthTokenWhenTheSessionHasExpired(t *testing.T) {
+	auth := &testapi.FakeAuthenticationRepository{RefreshAuthTokenError: true}
+
+	ui := callOAuthToken(auth, &testreq.FakeReqFactory{LoginSuccess: true})
+
+	assert.Equal(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "Your session has expired")
+}
+
+func callOAuthToken(auth *testapi.FakeAuthenticationRepository, reqFactory *testreq.FakeReqFactory) (ui *testterm.FakeUI) {
+	ui = new(testterm.FakeUI)
+	cmd := NewOAuthToken(ui, auth)
+	testcmd.RunCommand(cmd, testcmd.NewContext("oauth-token", []string{}), reqFactory)
+	return
+}
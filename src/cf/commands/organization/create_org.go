@@ -3,6 +3,7 @@ package organization
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -22,33 +23,35 @@ func NewCreateOrg(ui terminal.UI, orgRepo api.OrganizationRepository) (cmd Creat
 
 func (cmd CreateOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 1 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "create-org")
 		return
 	}
 
 	reqs = []requirements.Requirement{
 		reqFactory.NewLoginRequirement(),
+		reqFactory.NewAdminScopeRequirement(),
 	}
 	return
 }
 
-func (cmd CreateOrg) Run(c *cli.Context) {
+func (cmd CreateOrg) Run(c *cli.Context) (err error) {
 	name := c.Args()[0]
 
-	cmd.ui.Say("Creating org %s...", terminal.EntityNameColor(name))
+	cmd.ui.Status(i18n.T("create_org.creating_org"), terminal.EntityNameColor(name))
 	apiResponse := cmd.orgRepo.Create(name)
 	if apiResponse.IsNotSuccessful() {
 		if apiResponse.ErrorCode == cf.ORG_EXISTS {
 			cmd.ui.Ok()
-			cmd.ui.Warn("Org %s already exists", name)
+			cmd.ui.Warn(i18n.T("create_org.org_already_exists"), name)
 			return
 		}
 
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.Say("\nTIP: Use '%s' to target new org", terminal.CommandColor(cf.Name+" target -o "+name))
+	cmd.ui.Status(i18n.T("create_org.target_tip"), terminal.CommandColor(cf.Name+" target -o "+name))
+	return
 }
@@ -4,6 +4,7 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -27,22 +28,26 @@ func NewDeleteOrg(ui terminal.UI, sR api.OrganizationRepository, cR configuratio
 
 func (cmd *DeleteOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 1 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "delete-org")
 		return
 
 	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewAdminScopeRequirement(),
+	}
 	return
 }
 
-func (cmd *DeleteOrg) Run(c *cli.Context) {
+func (cmd *DeleteOrg) Run(c *cli.Context) (err error) {
 	orgName := c.Args()[0]
 
 	force := c.Bool("f")
 
 	if !force {
 		response := cmd.ui.Confirm(
-			"Really delete org %s and everything associated with it?%s",
+			i18n.T("delete_org.confirm"),
 			terminal.EntityNameColor(orgName),
 			terminal.PromptColor(">"),
 		)
@@ -52,29 +57,30 @@ func (cmd *DeleteOrg) Run(c *cli.Context) {
 		}
 	}
 
-	cmd.ui.Say("Deleting org %s...", terminal.EntityNameColor(orgName))
+	cmd.ui.Status(i18n.T("delete_org.deleting_org"), terminal.EntityNameColor(orgName))
 
 	org, apiResponse := cmd.orgRepo.FindByName(orgName)
 
 	if apiResponse.IsError() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	if apiResponse.IsNotFound() {
 		cmd.ui.Ok()
-		cmd.ui.Warn("Org %s does not exist.", orgName)
+		cmd.ui.Warn(i18n.T("delete_org.org_not_found"), orgName)
 		return
 	}
 
 	apiResponse = cmd.orgRepo.Delete(org)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 	config, err := cmd.configRepo.Get()
 	if err != nil {
-		cmd.ui.Failed("Couldn't reset your target. You should logout and log in again.")
+		err = cf.NewCommandError(cf.GeneralError, "%s", i18n.T("delete_org.could_not_reset_target"))
+		cmd.ui.Failed(err.Error())
 		return
 	}
 
@@ -2,6 +2,7 @@ package organization
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -25,18 +26,23 @@ func (cmd ListOrgs) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 	return
 }
 
-func (cmd ListOrgs) Run(c *cli.Context) {
-	cmd.ui.Say("Getting orgs...")
+func (cmd ListOrgs) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("list_orgs.getting_orgs"))
 
 	orgs, apiResponse := cmd.orgRepo.FindAll()
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	err = cmd.ui.Render(orgs)
+	if err != nil {
+		return
+	}
 
 	for _, org := range orgs {
 		cmd.ui.Say(org.Name)
 	}
+	return
 }
@@ -3,6 +3,7 @@ package organization_test
 import (
 	"cf"
 	. "cf/commands/organization"
+	"errors"
 	"github.com/stretchr/testify/assert"
 	testapi "testhelpers/api"
 	testcmd "testhelpers/commands"
@@ -40,6 +41,20 @@ func TestListOrgs(t *testing.T) {
 	assert.Contains(t, ui.Outputs[1], "OK")
 	assert.Contains(t, ui.Outputs[2], "Organization-1")
 	assert.Contains(t, ui.Outputs[3], "Organization-2")
+
+	assert.Equal(t, ui.Rendered, []interface{}{orgs})
+}
+
+func TestListOrgsFailsWhenTheOrgsCannotBeRendered(t *testing.T) {
+	orgRepo := &testapi.FakeOrgRepository{
+		Organizations: []cf.Organization{cf.Organization{Name: "Organization-1"}},
+	}
+	ui := &testterm.FakeUI{RenderError: errors.New("cannot render")}
+
+	err := testcmd.RunCommand(NewListOrgs(ui, orgRepo), testcmd.NewContext("orgs", []string{}), &testreq.FakeReqFactory{LoginSuccess: true})
+
+	assert.Error(t, err)
+	assert.NotContains(t, ui.DumpOutputs(), "Organization-1")
 }
 
 func callListOrgs(reqFactory *testreq.FakeReqFactory, orgRepo *testapi.FakeOrgRepository) (fakeUI *testterm.FakeUI) {
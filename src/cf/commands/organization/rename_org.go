@@ -2,6 +2,7 @@ package organization
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -23,7 +24,7 @@ func NewRenameOrg(ui terminal.UI, orgRepo api.OrganizationRepository) (cmd *Rena
 
 func (cmd *RenameOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "rename-org")
 		return
 	}
@@ -35,14 +36,15 @@ func (cmd *RenameOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 	return
 }
 
-func (cmd *RenameOrg) Run(c *cli.Context) {
+func (cmd *RenameOrg) Run(c *cli.Context) (err error) {
 	org := cmd.orgReq.GetOrganization()
-	cmd.ui.Say("Renaming org %s...", terminal.EntityNameColor(org.Name))
+	cmd.ui.Status(i18n.T("rename_org.renaming"), terminal.EntityNameColor(org.Name))
 
 	apiResponse := cmd.orgRepo.Rename(org, c.Args()[1])
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 	cmd.ui.Ok()
+	return
 }
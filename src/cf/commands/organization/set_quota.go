@@ -2,6 +2,7 @@ package organization
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -23,7 +24,7 @@ func NewSetQuota(ui terminal.UI, orgRepo api.OrganizationRepository) (cmd *SetQu
 
 func (cmd *SetQuota) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "set-quota")
 		return
 	}
@@ -37,25 +38,26 @@ func (cmd *SetQuota) GetRequirements(reqFactory requirements.Factory, c *cli.Con
 	return
 }
 
-func (cmd *SetQuota) Run(c *cli.Context) {
+func (cmd *SetQuota) Run(c *cli.Context) (err error) {
 	org := cmd.orgReq.GetOrganization()
 	quotaName := c.Args()[1]
 	quota, apiResponse := cmd.orgRepo.FindQuotaByName(quotaName)
 
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
-	cmd.ui.Say("Setting quota %s to org %s...",
+	cmd.ui.Status(i18n.T("set_quota.setting_quota"),
 		terminal.EntityNameColor(quota.Name),
 		terminal.EntityNameColor(org.Name))
 
 	apiResponse = cmd.orgRepo.UpdateQuota(org, quota)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
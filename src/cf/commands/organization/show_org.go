@@ -1,6 +1,7 @@
 package organization
 
 import (
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -21,7 +22,7 @@ func NewShowOrg(ui terminal.UI) (cmd *ShowOrg) {
 
 func (cmd *ShowOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 1 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "org")
 		return
 	}
@@ -35,9 +36,9 @@ func (cmd *ShowOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 	return
 }
 
-func (cmd *ShowOrg) Run(c *cli.Context) {
+func (cmd *ShowOrg) Run(c *cli.Context) (err error) {
 	org := cmd.orgReq.GetOrganization()
-	cmd.ui.Say("Getting info for org %s...", org.Name)
+	cmd.ui.Status(i18n.T("show_org.getting_info"), org.Name)
 	cmd.ui.Ok()
 	cmd.ui.Say("%s:", terminal.EntityNameColor(org.Name))
 
@@ -51,6 +52,7 @@ func (cmd *ShowOrg) Run(c *cli.Context) {
 		spaces = append(spaces, space.Name)
 	}
 
-	cmd.ui.Say("  domains: %s", terminal.EntityNameColor(strings.Join(domains, ", ")))
-	cmd.ui.Say("  spaces: %s", terminal.EntityNameColor(strings.Join(spaces, ", ")))
+	cmd.ui.Say(i18n.T("show_org.domains"), terminal.EntityNameColor(strings.Join(domains, ", ")))
+	cmd.ui.Say(i18n.T("show_org.spaces"), terminal.EntityNameColor(strings.Join(spaces, ", ")))
+	return
 }
@@ -1,8 +1,10 @@
 package commands
 
 import (
+	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -28,37 +30,39 @@ func (cmd Password) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 	return
 }
 
-func (cmd Password) Run(c *cli.Context) {
-	oldPassword := cmd.ui.AskForPassword("Current Password%s", terminal.PromptColor(">"))
-	newPassword := cmd.ui.AskForPassword("New Password%s", terminal.PromptColor(">"))
-	verifiedPassword := cmd.ui.AskForPassword("Verify Password%s", terminal.PromptColor(">"))
+func (cmd Password) Run(c *cli.Context) (err error) {
+	oldPassword := cmd.ui.AskForPassword(i18n.T("password.current_password"), terminal.PromptColor(">"))
+	newPassword := cmd.ui.AskForPassword(i18n.T("password.new_password"), terminal.PromptColor(">"))
+	verifiedPassword := cmd.ui.AskForPassword(i18n.T("password.verify_password"), terminal.PromptColor(">"))
 
 	if verifiedPassword != newPassword {
-		cmd.ui.Failed("Password verification does not match")
+		err = cf.NewCommandError(cf.GeneralError, "%s", i18n.T("password.verification_mismatch"))
+		cmd.ui.Failed(err.Error())
 		return
 	}
 
 	score, apiResponse := cmd.pwdRepo.GetScore(newPassword)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
-	cmd.ui.Say("Your password strength is: %s", score)
+	cmd.ui.Status(i18n.T("password.strength"), score)
 
-	cmd.ui.Say("Changing password...")
+	cmd.ui.Status(i18n.T("password.changing_password"))
 	apiResponse = cmd.pwdRepo.UpdatePassword(oldPassword, newPassword)
 
 	if apiResponse.IsNotSuccessful() {
 		if apiResponse.StatusCode == 401 {
-			cmd.ui.Failed("Current password did not match")
+			cmd.ui.Failed(i18n.T("password.current_password_mismatch"))
 		} else {
 			cmd.ui.Failed(apiResponse.Message)
 		}
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
 
 	cmd.configRepo.ClearSession()
-	cmd.ui.Say("Please log in again")
+	cmd.ui.Status(i18n.T("password.please_log_in"))
+	return
 }
@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"cf/configuration"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type Profile struct {
+	ui          terminal.UI
+	configRepo  configuration.ConfigurationRepository
+	profileRepo configuration.ProfileRepository
+}
+
+func NewProfile(ui terminal.UI, configRepo configuration.ConfigurationRepository, profileRepo configuration.ProfileRepository) (cmd Profile) {
+	cmd.ui = ui
+	cmd.configRepo = configRepo
+	cmd.profileRepo = profileRepo
+	return
+}
+
+func (cmd Profile) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	args := c.Args()
+
+	validUsage := false
+	if len(args) == 1 {
+		validUsage = args[0] == "list"
+	} else if len(args) == 2 {
+		validUsage = args[0] == "create" || args[0] == "use" || args[0] == "delete"
+	}
+
+	if !validUsage {
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
+		cmd.ui.FailWithUsage(c, "profile")
+	}
+	return
+}
+
+func (cmd Profile) Run(c *cli.Context) (err error) {
+	switch c.Args()[0] {
+	case "list":
+		err = cmd.list()
+	case "create":
+		err = cmd.create(c.Args()[1])
+	case "use":
+		err = cmd.use(c.Args()[1])
+	case "delete":
+		err = cmd.delete(c.Args()[1])
+	}
+	return
+}
+
+func (cmd Profile) list() (err error) {
+	cmd.ui.Status(i18n.T("profile.getting_profiles"))
+
+	names, err := cmd.profileRepo.List()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Status("")
+
+	current := cmd.profileRepo.Current()
+	for _, name := range names {
+		if name == current {
+			cmd.ui.Say(i18n.T("profile.current"), terminal.EntityNameColor(name))
+		} else {
+			cmd.ui.Say(name)
+		}
+	}
+	return
+}
+
+func (cmd Profile) create(name string) (err error) {
+	cmd.ui.Status(i18n.T("profile.creating_profile"), terminal.EntityNameColor(name))
+
+	err = cmd.profileRepo.Create(name)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+	return
+}
+
+func (cmd Profile) use(name string) (err error) {
+	cmd.ui.Status(i18n.T("profile.switching_to_profile"), terminal.EntityNameColor(name))
+
+	err = cmd.profileRepo.Use(name)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	config, err := cmd.configRepo.Get()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.ShowConfiguration(config)
+	return
+}
+
+func (cmd Profile) delete(name string) (err error) {
+	cmd.ui.Status(i18n.T("profile.deleting_profile"), terminal.EntityNameColor(name))
+
+	err = cmd.profileRepo.Delete(name)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+	return
+}
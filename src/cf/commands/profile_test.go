@@ -0,0 +1,108 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+	"testing"
+)
+
+func TestProfileFailsWithUsage(t *testing.T) {
+	profileRepo := &testconfig.FakeProfileRepository{}
+
+	ui := callProfile([]string{}, profileRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callProfile([]string{"create"}, profileRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callProfile([]string{"list", "extra"}, profileRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callProfile([]string{"rename", "staging"}, profileRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callProfile([]string{"list"}, profileRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestListingProfiles(t *testing.T) {
+	profileRepo := &testconfig.FakeProfileRepository{
+		CurrentName: "staging",
+		Names:       []string{"default", "production", "staging"},
+	}
+
+	ui := callProfile([]string{"list"}, profileRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Getting profiles")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, ui.Outputs[3], "default")
+	assert.Equal(t, ui.Outputs[4], "production")
+	assert.Contains(t, ui.Outputs[5], "staging")
+	assert.Contains(t, ui.Outputs[5], "(current)")
+}
+
+func TestCreatingAProfile(t *testing.T) {
+	profileRepo := &testconfig.FakeProfileRepository{Names: []string{"default"}}
+
+	ui := callProfile([]string{"create", "staging"}, profileRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Creating profile")
+	assert.Contains(t, ui.Outputs[0], "staging")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, profileRepo.CreatedName, "staging")
+}
+
+func TestCreatingAProfileThatAlreadyExists(t *testing.T) {
+	profileRepo := &testconfig.FakeProfileRepository{Names: []string{"default", "staging"}}
+
+	ui := callProfile([]string{"create", "staging"}, profileRepo)
+
+	assert.Equal(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "already exists")
+}
+
+func TestUsingAProfile(t *testing.T) {
+	profileRepo := &testconfig.FakeProfileRepository{
+		CurrentName: "default",
+		Names:       []string{"default", "staging"},
+	}
+
+	ui := callProfile([]string{"use", "staging"}, profileRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Switching to profile")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, profileRepo.UsedName, "staging")
+	assert.Contains(t, ui.DumpOutputs(), "API endpoint")
+}
+
+func TestUsingAProfileThatDoesNotExist(t *testing.T) {
+	profileRepo := &testconfig.FakeProfileRepository{Names: []string{"default"}}
+
+	ui := callProfile([]string{"use", "staging"}, profileRepo)
+
+	assert.Equal(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "does not exist")
+	assert.Empty(t, profileRepo.UsedName)
+}
+
+func TestDeletingAProfile(t *testing.T) {
+	profileRepo := &testconfig.FakeProfileRepository{Names: []string{"default", "staging"}}
+
+	ui := callProfile([]string{"delete", "staging"}, profileRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Deleting profile")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, profileRepo.DeletedName, "staging")
+}
+
+func callProfile(args []string, profileRepo *testconfig.FakeProfileRepository) (ui *testterm.FakeUI) {
+	ui = new(testterm.FakeUI)
+	configRepo := testconfig.FakeConfigRepository{}
+	cmd := NewProfile(ui, configRepo, profileRepo)
+	testcmd.RunCommand(cmd, testcmd.NewContext("profile", args), &testreq.FakeReqFactory{})
+	return
+}
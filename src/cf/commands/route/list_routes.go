@@ -3,6 +3,7 @@ package route
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -27,21 +28,25 @@ func (cmd ListRoutes) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 	return
 }
 
-func (cmd ListRoutes) Run(c *cli.Context) {
-	cmd.ui.Say("Getting routes in space %s...", terminal.EntityNameColor(cmd.config.Space.Name))
+func (cmd ListRoutes) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("list_routes.getting_routes"), terminal.EntityNameColor(cmd.config.Space.Name))
 
 	routes, apiResponse := cmd.routeRepo.FindAll()
 
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.Say("")
+	err = cmd.ui.Render(routes)
+	if err != nil {
+		return
+	}
+	cmd.ui.Status("")
 
 	if len(routes) == 0 {
-		cmd.ui.Say("No routes found")
+		cmd.ui.Say(i18n.T("list_routes.no_routes_found"))
 		return
 	}
 
@@ -57,5 +62,5 @@ func (cmd ListRoutes) Run(c *cli.Context) {
 		})
 	}
 
-	cmd.ui.DisplayTable(table)
+	return cmd.ui.DisplayTable(table)
 }
@@ -62,6 +62,7 @@ func TestListingRoutesWhenNoneExist(t *testing.T) {
 	assert.Contains(t, ui.Outputs[0], "Getting routes")
 	assert.Contains(t, ui.Outputs[1], "OK")
 	assert.Contains(t, ui.Outputs[3], "No routes found")
+	assert.Equal(t, ui.Rendered, []interface{}{routes})
 }
 
 func TestListingRoutesWhenFindFails(t *testing.T) {
@@ -3,6 +3,7 @@ package route
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -26,7 +27,7 @@ func NewReserveRoute(ui terminal.UI, routeRepo api.RouteRepository) (cmd *Reserv
 func (cmd *ReserveRoute) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "reserve-route")
 		return
 	}
@@ -41,19 +42,20 @@ func (cmd *ReserveRoute) GetRequirements(reqFactory requirements.Factory, c *cli
 	return
 }
 
-func (cmd *ReserveRoute) Run(c *cli.Context) {
+func (cmd *ReserveRoute) Run(c *cli.Context) (err error) {
 	space := cmd.spaceReq.GetSpace()
 	domain := cmd.domainReq.GetDomain()
 	route := cf.Route{Host: c.String("n"), Domain: domain}
 
-	cmd.ui.Say("Reserving url route %s for space %s...",
+	cmd.ui.Status(i18n.T("reserve_route.reserving"),
 		terminal.EntityNameColor(route.URL()), terminal.EntityNameColor(space.Name))
 
 	_, apiResponse := cmd.routeRepo.CreateInSpace(route, domain, space)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
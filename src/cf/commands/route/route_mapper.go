@@ -2,6 +2,7 @@ package route
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/net"
 	"cf/requirements"
 	"cf/terminal"
@@ -27,7 +28,7 @@ func NewRouteMapper(ui terminal.UI, routeRepo api.RouteRepository, bind bool) (c
 
 func (cmd *RouteMapper) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		if cmd.bind {
 			cmd.ui.FailWithUsage(c, "map-route")
 		} else {
@@ -51,20 +52,20 @@ func (cmd *RouteMapper) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *RouteMapper) Run(c *cli.Context) {
+func (cmd *RouteMapper) Run(c *cli.Context) (err error) {
 	route := cmd.routeReq.GetRoute()
 	app := cmd.appReq.GetApplication()
 
 	var apiResponse net.ApiResponse
 
 	if cmd.bind {
-		cmd.ui.Say("Adding url route %s to app %s...",
+		cmd.ui.Status(i18n.T("route_mapper.adding_route"),
 			terminal.EntityNameColor(route.URL()),
 			terminal.EntityNameColor(app.Name))
 
 		apiResponse = cmd.routeRepo.Bind(route, app)
 	} else {
-		cmd.ui.Say("Removing url route %s from app %s...",
+		cmd.ui.Status(i18n.T("route_mapper.removing_route"),
 			terminal.EntityNameColor(route.URL()),
 			terminal.EntityNameColor(app.Name))
 
@@ -73,8 +74,9 @@ func (cmd *RouteMapper) Run(c *cli.Context) {
 
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -1,28 +1,87 @@
 package commands
 
 import (
+	"cf"
+	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
-	"errors"
+	"cf/terminal"
+	"flag"
+	"fmt"
 	"github.com/codegangsta/cli"
 )
 
+const (
+	ExitCodeSuccess  = 0
+	ExitCodeFailure  = 1
+	ExitCodeUsage    = 2
+	ExitCodeAuth     = 3
+	ExitCodeNotFound = 4
+	ExitCodeServer   = 5
+)
+
 type Runner struct {
 	cmdFactory Factory
 	reqFactory requirements.Factory
+	ui         terminal.UI
+	exitCode   *int
 }
 
-func NewRunner(cmdFactory Factory, reqFactory requirements.Factory) (runner Runner) {
+func NewRunner(cmdFactory Factory, reqFactory requirements.Factory, ui terminal.UI) (runner Runner) {
 	runner.cmdFactory = cmdFactory
 	runner.reqFactory = reqFactory
+	runner.ui = ui
+	runner.exitCode = new(int)
 	return
 }
 
 type Command interface {
 	GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error)
-	Run(c *cli.Context)
+	Run(c *cli.Context) (err error)
 }
 
 func (runner Runner) RunCmdByName(cmdName string, c *cli.Context) (err error) {
+	defer func() {
+		*runner.exitCode = ExitCodeFor(err)
+		if cf.ErrorKindOf(err) == cf.SessionExpiredError && cmdName != "login" {
+			runner.offerLogin()
+		}
+	}()
+
+	err = terminal.SetOutputFormat(c.GlobalString("output"))
+	if err != nil {
+		runner.ui.Failed(err.Error())
+		err = cf.NewCommandError(cf.UsageError, "%s", err.Error())
+		return
+	}
+
+	terminal.SetQuiet(c.GlobalBool("quiet"))
+	terminal.SetWideOutput(c.GlobalBool("wide"))
+
+	err = terminal.SetTableOptions(c.GlobalString("sort"), c.GlobalStringSlice("filter"), c.GlobalString("columns"))
+	if err != nil {
+		runner.ui.Failed(err.Error())
+		err = cf.NewCommandError(cf.UsageError, "%s", err.Error())
+		return
+	}
+
+	// Structured output is never a table, so the table options would be ignored
+	if terminal.IsMachineReadableOutput() && terminal.HasTableOptions() {
+		err = cf.NewCommandError(cf.UsageError, i18n.T("runner.table_options_with_output"), terminal.CurrentOutputFormat())
+		runner.ui.Failed(err.Error())
+		return
+	}
+
+	// Every command but profile works with the current profile's config, the
+	// profile command is how an unknown profile gets created or replaced
+	if cmdName != "profile" {
+		err = configuration.CheckCurrentProfile()
+		if err != nil {
+			runner.ui.Failed("%s", err.Error())
+			return
+		}
+	}
+
 	cmd, err := runner.cmdFactory.GetByCmdName(cmdName)
 	if err != nil {
 		return
@@ -30,17 +89,76 @@ func (runner Runner) RunCmdByName(cmdName string, c *cli.Context) (err error) {
 
 	requirements, err := cmd.GetRequirements(runner.reqFactory, c)
 	if err != nil {
+		// Commands only refuse to run from GetRequirements when they are used incorrectly
+		if cf.ErrorKindOf(err) == cf.GeneralError {
+			err = cf.NewCommandError(cf.UsageError, "%s", err.Error())
+		}
 		return
 	}
 
 	for _, requirement := range requirements {
-		success := requirement.Execute()
-		if !success {
-			err = errors.New("Error in requirement")
+		err = requirement.Execute()
+		if err != nil {
 			return
 		}
 	}
 
-	cmd.Run(c)
+	err = cmd.Run(c)
 	return
 }
+
+// Commands report an expired session like any other failure, and leave it to
+// the runner to point the user at login, offering to run it when a terminal is
+// attached. The command still fails, so its exit code is kept.
+func (runner Runner) offerLogin() {
+	runner.ui.Warn(terminal.NotLoggedInText())
+
+	if !runner.ui.IsInteractive() || !runner.ui.Confirm(i18n.T("runner.log_in_again"), terminal.PromptColor(">")) {
+		return
+	}
+
+	login, err := runner.cmdFactory.GetByCmdName("login")
+	if err != nil {
+		return
+	}
+
+	flags := flag.NewFlagSet("login", flag.ContinueOnError)
+	login.Run(cli.NewContext(nil, flags, flags))
+}
+
+// Reports a command name the app does not know, suggesting the closest of
+// the command names it does know.
+func (runner Runner) FailUnknownCommand(cmdName string, cmdNames []string) (err error) {
+	message := fmt.Sprintf(i18n.T("runner.unknown_command"), cmdName, cf.Name)
+	if suggestions := cf.ClosestNames(cmdName, cmdNames); len(suggestions) > 0 {
+		message += "\n" + cf.DidYouMean(suggestions)
+	}
+
+	runner.ui.Failed("%s", message)
+	err = cf.NewCommandError(cf.UsageError, "%s", message)
+	*runner.exitCode = ExitCodeFor(err)
+	return
+}
+
+// The exit code of the last command run
+func (runner Runner) ExitCode() int {
+	return *runner.exitCode
+}
+
+func ExitCodeFor(err error) int {
+	if err == nil {
+		return ExitCodeSuccess
+	}
+
+	switch cf.ErrorKindOf(err) {
+	case cf.UsageError:
+		return ExitCodeUsage
+	case cf.AuthError, cf.SessionExpiredError:
+		return ExitCodeAuth
+	case cf.NotFoundError:
+		return ExitCodeNotFound
+	case cf.ServerError:
+		return ExitCodeServer
+	}
+	return ExitCodeFailure
+}
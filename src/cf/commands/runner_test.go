@@ -1,20 +1,33 @@
 package commands_test
 
 import (
+	"cf"
 	. "cf/commands"
+	"cf/i18n"
 	"cf/requirements"
+	"cf/terminal"
+	"errors"
+	"flag"
 	"github.com/codegangsta/cli"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
 	testcmd "testhelpers/commands"
+	testterm "testhelpers/terminal"
 	"testing"
 )
 
 type TestCommandFactory struct {
-	Cmd     Command
-	CmdName string
+	Cmd      Command
+	LoginCmd Command
+	CmdName  string
 }
 
 func (f *TestCommandFactory) GetByCmdName(cmdName string) (cmd Command, err error) {
+	if cmdName == "login" && f.LoginCmd != nil {
+		return f.LoginCmd, nil
+	}
+
 	f.CmdName = cmdName
 	cmd = f.Cmd
 	return
@@ -22,6 +35,7 @@ func (f *TestCommandFactory) GetByCmdName(cmdName string) (cmd Command, err erro
 
 type TestCommand struct {
 	Reqs       []requirements.Requirement
+	RunError   error
 	WasRunWith *cli.Context
 }
 
@@ -30,8 +44,9 @@ func (cmd *TestCommand) GetRequirements(factory requirements.Factory, c *cli.Con
 	return
 }
 
-func (cmd *TestCommand) Run(c *cli.Context) {
+func (cmd *TestCommand) Run(c *cli.Context) (err error) {
 	cmd.WasRunWith = c
+	return cmd.RunError
 }
 
 type TestRequirement struct {
@@ -39,14 +54,14 @@ type TestRequirement struct {
 	WasExecuted bool
 }
 
-func (r *TestRequirement) Execute() (success bool) {
+func (r *TestRequirement) Execute() (err error) {
 	r.WasExecuted = true
 
 	if !r.Passes {
-		return false
+		return cf.NewCommandError(cf.AuthError, "Not logged in")
 	}
 
-	return true
+	return
 }
 
 func TestRun(t *testing.T) {
@@ -59,7 +74,7 @@ func TestRun(t *testing.T) {
 	}
 
 	cmdFactory := &TestCommandFactory{Cmd: &cmd}
-	runner := NewRunner(cmdFactory, nil)
+	runner := NewRunner(cmdFactory, nil, &testterm.FakeUI{})
 
 	ctxt := testcmd.NewContext("login", []string{})
 
@@ -74,4 +89,150 @@ func TestRun(t *testing.T) {
 	assert.Nil(t, cmd.WasRunWith)
 
 	assert.Error(t, err)
+	assert.Equal(t, runner.ExitCode(), ExitCodeAuth)
+}
+
+func TestRunReturnsTheCommandError(t *testing.T) {
+	cmd := TestCommand{RunError: cf.NewCommandError(cf.NotFoundError, "App my-app not found")}
+	runner := NewRunner(&TestCommandFactory{Cmd: &cmd}, nil, &testterm.FakeUI{})
+
+	err := runner.RunCmdByName("some-cmd", testcmd.NewContext("app", []string{}))
+
+	assert.NotNil(t, cmd.WasRunWith)
+	assert.Equal(t, err, cmd.RunError)
+	assert.Equal(t, runner.ExitCode(), ExitCodeNotFound)
+
+	cmd.RunError = nil
+	err = runner.RunCmdByName("some-cmd", testcmd.NewContext("app", []string{}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, runner.ExitCode(), ExitCodeSuccess)
+}
+
+func TestRunOnlyRunsTheProfileCommandWithAnUnknownProfile(t *testing.T) {
+	home, err := ioutil.TempDir("", "cf-home")
+	assert.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	oldHome := os.Getenv("CF_HOME")
+	oldProfile := os.Getenv("CF_PROFILE")
+	os.Setenv("CF_HOME", home)
+	os.Setenv("CF_PROFILE", "staging")
+	defer os.Setenv("CF_HOME", oldHome)
+	defer os.Setenv("CF_PROFILE", oldProfile)
+
+	cmd := TestCommand{}
+	ui := &testterm.FakeUI{}
+	runner := NewRunner(&TestCommandFactory{Cmd: &cmd}, nil, ui)
+
+	err = runner.RunCmdByName("apps", testcmd.NewContext("apps", []string{}))
+
+	assert.Nil(t, cmd.WasRunWith)
+	assert.Error(t, err)
+	assert.Equal(t, runner.ExitCode(), ExitCodeFailure)
+	assert.Contains(t, ui.DumpOutputs(), "Profile staging does not exist")
+
+	err = runner.RunCmdByName("profile", testcmd.NewContext("profile", []string{"create", "staging"}))
+
+	assert.NotNil(t, cmd.WasRunWith)
+	assert.NoError(t, err)
+}
+
+func TestRunTreatsRequirementErrorsAsUsageErrors(t *testing.T) {
+	cmd := &TestCommandWithUsageError{}
+	runner := NewRunner(&TestCommandFactory{Cmd: cmd}, nil, &testterm.FakeUI{})
+
+	err := runner.RunCmdByName("some-cmd", testcmd.NewContext("app", []string{}))
+
+	assert.Equal(t, cf.ErrorKindOf(err), cf.UsageError)
+	assert.Equal(t, runner.ExitCode(), ExitCodeUsage)
+}
+
+func TestRunOffersToLogInAgainWhenTheSessionHasExpired(t *testing.T) {
+	cmd := TestCommand{RunError: cf.NewCommandError(cf.SessionExpiredError, "Your session has expired.")}
+	login := TestCommand{}
+	ui := &testterm.FakeUI{Inputs: []string{"y"}}
+	runner := NewRunner(&TestCommandFactory{Cmd: &cmd, LoginCmd: &login}, nil, ui)
+
+	err := runner.RunCmdByName("some-cmd", testcmd.NewContext("app", []string{}))
+
+	assert.Equal(t, err, cmd.RunError)
+	assert.Equal(t, runner.ExitCode(), ExitCodeAuth)
+	assert.Contains(t, ui.Outputs[0], "Not logged in. Use 'cf login' to log in.")
+	assert.Contains(t, ui.Prompts[0], "Log in again now?")
+	assert.NotNil(t, login.WasRunWith)
+}
+
+func TestRunOnlyPointsAtLoginWhenNotInteractive(t *testing.T) {
+	cmd := TestCommand{RunError: cf.NewCommandError(cf.SessionExpiredError, "Your session has expired.")}
+	login := TestCommand{}
+	ui := &testterm.FakeUI{NonInteractive: true}
+	runner := NewRunner(&TestCommandFactory{Cmd: &cmd, LoginCmd: &login}, nil, ui)
+
+	runner.RunCmdByName("some-cmd", testcmd.NewContext("app", []string{}))
+
+	assert.Contains(t, ui.Outputs[0], "Not logged in. Use 'cf login' to log in.")
+	assert.Empty(t, ui.Prompts)
+	assert.Nil(t, login.WasRunWith)
+}
+
+func TestRunDoesNotOfferToLogInForOtherFailures(t *testing.T) {
+	cmd := TestCommand{RunError: cf.NewCommandError(cf.AuthError, "Forbidden")}
+	ui := &testterm.FakeUI{}
+	runner := NewRunner(&TestCommandFactory{Cmd: &cmd}, nil, ui)
+
+	runner.RunCmdByName("some-cmd", testcmd.NewContext("app", []string{}))
+
+	assert.Empty(t, ui.Outputs)
+	assert.Empty(t, ui.Prompts)
+}
+
+func TestRunRejectsTableOptionsWithStructuredOutput(t *testing.T) {
+	cmd := TestCommand{}
+	ui := &testterm.FakeUI{}
+	runner := NewRunner(&TestCommandFactory{Cmd: &cmd}, nil, ui)
+
+	globalSet := flag.NewFlagSet("cf", flag.ContinueOnError)
+	globalSet.String("output", "json", "")
+	globalSet.String("sort", "name", "")
+	defer terminal.SetOutputFormat("")
+	defer terminal.SetTableOptions("", []string{}, "")
+
+	err := runner.RunCmdByName("some-cmd", cli.NewContext(nil, flag.NewFlagSet("apps", flag.ContinueOnError), globalSet))
+
+	assert.Equal(t, cf.ErrorKindOf(err), cf.UsageError)
+	assert.Nil(t, cmd.WasRunWith)
+	assert.Contains(t, ui.Outputs[1], "cannot be used with --output json")
+}
+
+type TestCommandWithUsageError struct {
+	TestCommand
+}
+
+func (cmd *TestCommandWithUsageError) GetRequirements(factory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	err = errors.New(i18n.T("terminal.incorrect_usage"))
+	return
+}
+
+func TestExitCodeFor(t *testing.T) {
+	assert.Equal(t, ExitCodeFor(nil), ExitCodeSuccess)
+	assert.Equal(t, ExitCodeFor(errors.New("something went wrong")), ExitCodeFailure)
+	assert.Equal(t, ExitCodeFor(cf.NewCommandError(cf.GeneralError, "Failed")), ExitCodeFailure)
+	assert.Equal(t, ExitCodeFor(cf.NewCommandError(cf.UsageError, "Incorrect Usage")), ExitCodeUsage)
+	assert.Equal(t, ExitCodeFor(cf.NewCommandError(cf.AuthError, "Not logged in")), ExitCodeAuth)
+	assert.Equal(t, ExitCodeFor(cf.NewCommandError(cf.SessionExpiredError, "Session expired")), ExitCodeAuth)
+	assert.Equal(t, ExitCodeFor(cf.NewCommandError(cf.NotFoundError, "App not found")), ExitCodeNotFound)
+	assert.Equal(t, ExitCodeFor(cf.NewCommandError(cf.ServerError, "Server error")), ExitCodeServer)
+}
+
+func TestFailUnknownCommandSuggestsCommands(t *testing.T) {
+	ui := &testterm.FakeUI{}
+	runner := NewRunner(&TestCommandFactory{}, nil, ui)
+
+	err := runner.FailUnknownCommand("pushh", []string{"push", "apps", "start"})
+
+	assert.Equal(t, cf.ErrorKindOf(err), cf.UsageError)
+	assert.Equal(t, runner.ExitCode(), ExitCodeUsage)
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "'pushh' is not a registered command. See 'cf help'\nDid you mean push?")
 }
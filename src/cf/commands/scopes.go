@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"cf/configuration"
+	"cf/i18n"
+	"cf/terminal"
+)
+
+// The scopes every user needs, each with the ID of the message saying what it
+// is needed for
+var expectedScopes = []struct {
+	scope     string
+	purposeID string
+}{
+	{"cloud_controller.read", "scopes.read_purpose"},
+	{"cloud_controller.write", "scopes.write_purpose"},
+}
+
+// Only some users are granted the admin scope, so its absence is mentioned
+// without a warning.
+func warnAboutMissingScopes(ui terminal.UI, info configuration.TokenInfo) {
+	warned := false
+	for _, expected := range expectedScopes {
+		if !info.HasScope(expected.scope) {
+			if !warned {
+				ui.Status("")
+				warned = true
+			}
+			ui.Warn(i18n.T("scopes.missing_scope"), expected.scope, i18n.T(expected.purposeID))
+		}
+	}
+
+	if !info.HasScope(configuration.AdminScope) {
+		ui.Status(i18n.T("scopes.admin_scope_not_granted"), configuration.AdminScope, i18n.T("scopes.admin_purpose"))
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -25,7 +26,7 @@ func NewBindService(ui terminal.UI, sR api.ServiceRepository) (cmd *BindService)
 func (cmd *BindService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "bind-service")
 		return
 	}
@@ -39,24 +40,25 @@ func (cmd *BindService) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *BindService) Run(c *cli.Context) {
+func (cmd *BindService) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 	instance := cmd.serviceInstanceReq.GetServiceInstance()
 
-	cmd.ui.Say("Binding service %s to %s...", terminal.EntityNameColor(instance.Name), terminal.EntityNameColor(app.Name))
+	cmd.ui.Status(i18n.T("bind_service.binding"), terminal.EntityNameColor(instance.Name), terminal.EntityNameColor(app.Name))
 
 	apiResponse := cmd.serviceRepo.BindService(instance, app)
 	if apiResponse.IsNotSuccessful() && apiResponse.ErrorCode != "90003" {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
 
 	if apiResponse.ErrorCode == "90003" {
-		cmd.ui.Warn("App %s is already bound to %s.", app.Name, instance.Name)
+		cmd.ui.Warn(i18n.T("bind_service.already_bound"), app.Name, instance.Name)
 		return
 	}
 
-	cmd.ui.Say("TIP: Use 'cf push' to ensure your env variable changes take effect")
+	cmd.ui.Status(i18n.T("bind_service.push_tip"))
+	return
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -23,7 +24,7 @@ func NewCreateService(ui terminal.UI, sR api.ServiceRepository) (cmd CreateServi
 
 func (cmd CreateService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 3 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "create-service")
 		return
 	}
@@ -31,7 +32,7 @@ func (cmd CreateService) GetRequirements(reqFactory requirements.Factory, c *cli
 	return
 }
 
-func (cmd CreateService) Run(c *cli.Context) {
+func (cmd CreateService) Run(c *cli.Context) (err error) {
 	offeringName := c.Args()[0]
 	planName := c.Args()[1]
 	name := c.Args()[2]
@@ -39,35 +40,36 @@ func (cmd CreateService) Run(c *cli.Context) {
 	offerings, apiResponse := cmd.serviceRepo.GetServiceOfferings()
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	offering, err := findOffering(offerings, offeringName)
 	if err != nil {
 		cmd.ui.Failed(err.Error())
-		return
+		return err
 	}
 
 	plan, err := findPlan(offering.Plans, planName)
 	if err != nil {
 		cmd.ui.Failed(err.Error())
-		return
+		return err
 	}
 
-	cmd.ui.Say("Creating service %s...", terminal.EntityNameColor(name))
+	cmd.ui.Status(i18n.T("create_service.creating_service"), terminal.EntityNameColor(name))
 
 	var identicalAlreadyExists bool
 	identicalAlreadyExists, apiResponse = cmd.serviceRepo.CreateServiceInstance(name, plan)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
 
 	if identicalAlreadyExists {
-		cmd.ui.Warn("Service %s already exists", name)
+		cmd.ui.Warn(i18n.T("create_service.service_already_exists"), name)
 	}
+	return
 }
 
 func findOffering(offerings []cf.ServiceOffering, name string) (offering cf.ServiceOffering, err error) {
@@ -77,7 +79,7 @@ func findOffering(offerings []cf.ServiceOffering, name string) (offering cf.Serv
 		}
 	}
 
-	err = errors.New(fmt.Sprintf("Could not find offering with name %s", name))
+	err = errors.New(fmt.Sprintf(i18n.T("create_service.offering_not_found"), name))
 	return
 }
 
@@ -88,6 +90,6 @@ func findPlan(plans []cf.ServicePlan, name string) (plan cf.ServicePlan, err err
 		}
 	}
 
-	err = errors.New(fmt.Sprintf("Could not find plan with name %s", name))
+	err = errors.New(fmt.Sprintf(i18n.T("create_service.plan_not_found"), name))
 	return
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"encoding/json"
@@ -23,7 +24,7 @@ func NewCreateUserProvidedService(ui terminal.UI, sR api.ServiceRepository) (cmd
 
 func (cmd CreateUserProvidedService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "create-user-provided-service")
 		return
 	}
@@ -31,27 +32,28 @@ func (cmd CreateUserProvidedService) GetRequirements(reqFactory requirements.Fac
 	return
 }
 
-func (cmd CreateUserProvidedService) Run(c *cli.Context) {
+func (cmd CreateUserProvidedService) Run(c *cli.Context) (err error) {
 	name := c.Args()[0]
 
 	params := c.Args()[1]
 	params = strings.Trim(params, `"`)
 	paramsMap := make(map[string]string)
 
-	err := json.Unmarshal([]byte(params), &paramsMap)
-	if err != nil {
+	jsonErr := json.Unmarshal([]byte(params), &paramsMap)
+	if jsonErr != nil {
 		paramsMap = cmd.mapValuesFromPrompt(params, paramsMap)
 	}
 
-	cmd.ui.Say("Creating user provided service...")
+	cmd.ui.Status(i18n.T("create_user_provided_service.creating"))
 
 	apiResponse := cmd.serviceRepo.CreateUserProvidedServiceInstance(name, paramsMap)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
 
 func (cmd CreateUserProvidedService) mapValuesFromPrompt(params string, paramsMap map[string]string) map[string]string {
@@ -2,6 +2,7 @@ package service
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -29,7 +30,7 @@ func (cmd *DeleteService) GetRequirements(reqFactory requirements.Factory, c *cl
 	}
 
 	if serviceName == "" {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "delete-service")
 		return
 	}
@@ -37,28 +38,30 @@ func (cmd *DeleteService) GetRequirements(reqFactory requirements.Factory, c *cl
 	return
 }
 
-func (cmd *DeleteService) Run(c *cli.Context) {
+func (cmd *DeleteService) Run(c *cli.Context) (err error) {
 	serviceName := c.Args()[0]
 
-	cmd.ui.Say("Deleting service %s...", terminal.EntityNameColor(serviceName))
+	cmd.ui.Status(i18n.T("delete_service.deleting_service"), terminal.EntityNameColor(serviceName))
 
 	instance, apiResponse := cmd.serviceRepo.FindInstanceByName(serviceName)
 
 	if apiResponse.IsError() {
 		cmd.ui.Failed(apiResponse.Message)
+		return apiResponse.AsError()
 	}
 
 	if apiResponse.IsNotFound() {
 		cmd.ui.Ok()
-		cmd.ui.Warn("Service %s does not exist.", serviceName)
+		cmd.ui.Warn(i18n.T("delete_service.service_not_found"), serviceName)
 		return
 	}
 
 	apiResponse = cmd.serviceRepo.DeleteService(instance)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
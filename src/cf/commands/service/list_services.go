@@ -2,6 +2,7 @@ package service
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -23,17 +24,21 @@ func (cmd ListServices) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd ListServices) Run(c *cli.Context) {
-	cmd.ui.Say("Getting services in %s...", cmd.spaceRepo.GetCurrentSpace().Name)
+func (cmd ListServices) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("list_services.getting_services"), cmd.spaceRepo.GetCurrentSpace().Name)
 
 	space, apiResponse := cmd.spaceRepo.GetSummary()
 
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	err = cmd.ui.Render(space.ServiceInstances)
+	if err != nil {
+		return
+	}
 
 	table := [][]string{
 		[]string{"name", "service", "plan", "bound apps"},
@@ -56,5 +61,5 @@ func (cmd ListServices) Run(c *cli.Context) {
 		})
 	}
 
-	cmd.ui.DisplayTable(table)
+	return cmd.ui.DisplayTable(table)
 }
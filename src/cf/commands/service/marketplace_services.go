@@ -2,6 +2,7 @@ package service
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -23,17 +24,21 @@ func (cmd MarketplaceServices) GetRequirements(reqFactory requirements.Factory,
 	return
 }
 
-func (cmd MarketplaceServices) Run(c *cli.Context) {
-	cmd.ui.Say("Getting services from marketplace...")
+func (cmd MarketplaceServices) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("marketplace_services.getting_services"))
 
 	serviceOfferings, apiResponse := cmd.serviceRepo.GetServiceOfferings()
 
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	err = cmd.ui.Render(serviceOfferings)
+	if err != nil {
+		return
+	}
 
 	table := [][]string{
 		[]string{"service", "plans", "description"},
@@ -52,6 +57,5 @@ func (cmd MarketplaceServices) Run(c *cli.Context) {
 		})
 	}
 
-	cmd.ui.DisplayTable(table)
-	return
+	return cmd.ui.DisplayTable(table)
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -24,7 +25,7 @@ func NewRenameService(ui terminal.UI, serviceRepo api.ServiceRepository) (cmd *R
 
 func (cmd *RenameService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("incorrect usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "rename-service")
 		return
 	}
@@ -40,19 +41,20 @@ func (cmd *RenameService) GetRequirements(reqFactory requirements.Factory, c *cl
 	return
 }
 
-func (cmd *RenameService) Run(c *cli.Context) {
+func (cmd *RenameService) Run(c *cli.Context) (err error) {
 	newName := c.Args()[1]
 	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
 
-	cmd.ui.Say("Renaming service %s...", serviceInstance.Name)
+	cmd.ui.Status(i18n.T("rename_service.renaming"), serviceInstance.Name)
 	apiResponse := cmd.serviceRepo.RenameService(serviceInstance, newName)
 	if apiResponse.IsNotSuccessful() {
 		if apiResponse.ErrorCode == cf.SERVICE_INSTANCE_NAME_TAKEN {
-			cmd.ui.Failed("%s\nTIP: Use '%s services' to view all services in this org and space.", apiResponse.Message, cf.Name)
+			cmd.ui.Failed(i18n.T("rename_service.services_tip"), apiResponse.Message, cf.Name)
 		} else {
 			cmd.ui.Failed(apiResponse.Message)
 		}
-		return
+		return apiResponse.AsError()
 	}
 	cmd.ui.Ok()
+	return
 }
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -20,7 +21,7 @@ func NewShowService(ui terminal.UI) (cmd *ShowService) {
 
 func (cmd *ShowService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 1 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "service")
 		return
 	}
@@ -35,18 +36,19 @@ func (cmd *ShowService) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *ShowService) Run(c *cli.Context) {
+func (cmd *ShowService) Run(c *cli.Context) (err error) {
 	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
 
 	cmd.ui.Say("")
-	cmd.ui.Say("Service instance: %s", terminal.EntityNameColor(serviceInstance.Name))
+	cmd.ui.Say(i18n.T("show_service.service_instance"), terminal.EntityNameColor(serviceInstance.Name))
 
 	if serviceInstance.IsUserProvided() {
-		cmd.ui.Say("Service: %s", terminal.EntityNameColor("user-provided"))
+		cmd.ui.Say(i18n.T("show_service.service"), terminal.EntityNameColor("user-provided"))
 	} else {
-		cmd.ui.Say("Service: %s", terminal.EntityNameColor(serviceInstance.ServiceOffering().Label))
-		cmd.ui.Say("Plan: %s", terminal.EntityNameColor(serviceInstance.ServicePlan.Name))
-		cmd.ui.Say("Description: %s", terminal.EntityNameColor(serviceInstance.ServiceOffering().Description))
-		cmd.ui.Say("Documentation url: %s", terminal.EntityNameColor(serviceInstance.ServiceOffering().DocumentationUrl))
+		cmd.ui.Say(i18n.T("show_service.service"), terminal.EntityNameColor(serviceInstance.ServiceOffering().Label))
+		cmd.ui.Say(i18n.T("show_service.plan"), terminal.EntityNameColor(serviceInstance.ServicePlan.Name))
+		cmd.ui.Say(i18n.T("show_service.description"), terminal.EntityNameColor(serviceInstance.ServiceOffering().Description))
+		cmd.ui.Say(i18n.T("show_service.documentation_url"), terminal.EntityNameColor(serviceInstance.ServiceOffering().DocumentationUrl))
 	}
+	return
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -24,7 +25,7 @@ func NewUnbindService(ui terminal.UI, sR api.ServiceRepository) (cmd *UnbindServ
 
 func (cmd *UnbindService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "unbind-service")
 		return
 	}
@@ -39,22 +40,22 @@ func (cmd *UnbindService) GetRequirements(reqFactory requirements.Factory, c *cl
 	return
 }
 
-func (cmd *UnbindService) Run(c *cli.Context) {
+func (cmd *UnbindService) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 	instance := cmd.serviceInstanceReq.GetServiceInstance()
 
-	cmd.ui.Say("Unbinding service %s from %s...", terminal.EntityNameColor(instance.Name), terminal.EntityNameColor(app.Name))
+	cmd.ui.Status(i18n.T("unbind_service.unbinding"), terminal.EntityNameColor(instance.Name), terminal.EntityNameColor(app.Name))
 
 	found, apiResponse := cmd.serviceRepo.UnbindService(instance, app)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
 
 	if !found {
-		cmd.ui.Warn("Binding between %s and %s did not exist", instance.Name, app.Name)
+		cmd.ui.Warn(i18n.T("unbind_service.binding_not_found"), instance.Name, app.Name)
 	}
-
+	return
 }
@@ -1,7 +1,9 @@
 package service
 
 import (
+	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"encoding/json"
@@ -24,7 +26,7 @@ func NewUpdateUserProvidedService(ui terminal.UI, sR api.ServiceRepository) (cmd
 
 func (cmd *UpdateUserProvidedService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "update-user-provided-service")
 		return
 	}
@@ -39,29 +41,32 @@ func (cmd *UpdateUserProvidedService) GetRequirements(reqFactory requirements.Fa
 	return
 }
 
-func (cmd *UpdateUserProvidedService) Run(c *cli.Context) {
+func (cmd *UpdateUserProvidedService) Run(c *cli.Context) (err error) {
 	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
 	if !serviceInstance.IsUserProvided() {
-		cmd.ui.Failed("Service Instance is not user provided")
+		err = cf.NewCommandError(cf.GeneralError, "%s", i18n.T("update_user_provided_service.not_user_provided"))
+		cmd.ui.Failed(err.Error())
 		return
 	}
 
 	params := c.Args()[1]
 	paramsMap := make(map[string]string)
 
-	err := json.Unmarshal([]byte(params), &paramsMap)
+	err = json.Unmarshal([]byte(params), &paramsMap)
 	if err != nil {
-		cmd.ui.Failed("JSON is invalid: %s", err.Error())
+		err = cf.NewCommandError(cf.GeneralError, i18n.T("update_user_provided_service.invalid_json"), err.Error())
+		cmd.ui.Failed(err.Error())
 		return
 	}
 
-	cmd.ui.Say("Updating user provided service %s...", serviceInstance.Name)
+	cmd.ui.Status(i18n.T("update_user_provided_service.updating"), serviceInstance.Name)
 
 	apiResponse := cmd.serviceRepo.UpdateUserProvidedServiceInstance(serviceInstance, paramsMap)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
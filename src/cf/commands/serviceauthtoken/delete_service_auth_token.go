@@ -3,6 +3,7 @@ package serviceauthtoken
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -23,16 +24,16 @@ func NewDeleteServiceAuthToken(ui terminal.UI, authTokenRepo api.ServiceAuthToke
 
 func (cmd DeleteServiceAuthToken) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "delete-service-auth-token")
 		return
 	}
 
-	reqs = append(reqs, reqFactory.NewLoginRequirement())
+	reqs = append(reqs, reqFactory.NewLoginRequirement(), reqFactory.NewAdminScopeRequirement())
 	return
 }
 
-func (cmd DeleteServiceAuthToken) Run(c *cli.Context) {
+func (cmd DeleteServiceAuthToken) Run(c *cli.Context) (err error) {
 
 	tokenLabel := c.Args()[0]
 	tokenProvider := c.Args()[1]
@@ -43,7 +44,7 @@ func (cmd DeleteServiceAuthToken) Run(c *cli.Context) {
 
 	if c.Bool("f") == false {
 		response := cmd.ui.Confirm(
-			"Are you sure you want to delete %s?%s",
+			i18n.T("delete_service_auth_token.confirm"),
 			terminal.EntityNameColor(fmt.Sprintf("%s %s", tokenLabel, tokenProvider)),
 			terminal.PromptColor(">"),
 		)
@@ -52,15 +53,15 @@ func (cmd DeleteServiceAuthToken) Run(c *cli.Context) {
 		}
 	}
 
-	cmd.ui.Say("Deleting service auth token...")
+	cmd.ui.Status(i18n.T("delete_service_auth_token.deleting"))
 	token, apiResponse := cmd.authTokenRepo.FindByName(token.FindByNameKey())
 	if apiResponse.IsError() {
-		cmd.ui.Failed("Error deleting service auth token.\n%s", apiResponse.Message)
-		return
+		cmd.ui.Failed(i18n.T("delete_service_auth_token.error_deleting"), apiResponse.Message)
+		return apiResponse.AsError()
 	}
 	if apiResponse.IsNotFound() {
 		cmd.ui.Ok()
-		cmd.ui.Warn("Service Auth Token %s %s does not exist.", tokenLabel, tokenProvider)
+		cmd.ui.Warn(i18n.T("delete_service_auth_token.token_not_found"), tokenLabel, tokenProvider)
 		return
 	}
 
@@ -70,4 +71,5 @@ func (cmd DeleteServiceAuthToken) Run(c *cli.Context) {
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -2,6 +2,7 @@ package serviceauthtoken
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -25,15 +26,15 @@ func (cmd ListServiceAuthTokens) GetRequirements(reqFactory requirements.Factory
 	return
 }
 
-func (cmd ListServiceAuthTokens) Run(c *cli.Context) {
-	cmd.ui.Say("Getting service auth tokens...")
+func (cmd ListServiceAuthTokens) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("list_service_auth_tokens.getting_tokens"))
 	authTokens, apiResponse := cmd.authTokenRepo.FindAll()
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 	cmd.ui.Ok()
-	cmd.ui.Say("")
+	cmd.ui.Status("")
 
 	table := [][]string{
 		{"label", "provider"},
@@ -43,5 +44,5 @@ func (cmd ListServiceAuthTokens) Run(c *cli.Context) {
 		table = append(table, []string{authToken.Label, authToken.Provider})
 	}
 
-	cmd.ui.DisplayTable(table)
+	return cmd.ui.DisplayTable(table)
 }
@@ -3,6 +3,7 @@ package serviceauthtoken
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -22,19 +23,20 @@ func NewUpdateServiceAuthToken(ui terminal.UI, authTokenRepo api.ServiceAuthToke
 
 func (cmd UpdateServiceAuthToken) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 3 {
-		err = errors.New("Incorrect usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "update-service-auth-token")
 		return
 	}
 
 	reqs = []requirements.Requirement{
 		reqFactory.NewLoginRequirement(),
+		reqFactory.NewAdminScopeRequirement(),
 	}
 	return
 }
 
-func (cmd UpdateServiceAuthToken) Run(c *cli.Context) {
-	cmd.ui.Say("Updating service auth token...")
+func (cmd UpdateServiceAuthToken) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("update_service_auth_token.updating"))
 
 	serviceAuthToken := cf.ServiceAuthToken{
 		Label:    c.Args()[0],
@@ -45,8 +47,9 @@ func (cmd UpdateServiceAuthToken) Run(c *cli.Context) {
 	apiResponse := cmd.authTokenRepo.Update(serviceAuthToken)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
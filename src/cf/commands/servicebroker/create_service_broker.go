@@ -3,6 +3,7 @@ package servicebroker
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -23,7 +24,7 @@ func NewCreateServiceBroker(ui terminal.UI, serviceBrokerRepo api.ServiceBrokerR
 func (cmd CreateServiceBroker) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 
 	if len(c.Args()) != 4 {
-		err = errors.New("Incorrect usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "create-service-broker")
 		return
 	}
@@ -33,20 +34,21 @@ func (cmd CreateServiceBroker) GetRequirements(reqFactory requirements.Factory,
 	return
 }
 
-func (cmd CreateServiceBroker) Run(c *cli.Context) {
+func (cmd CreateServiceBroker) Run(c *cli.Context) (err error) {
 	serviceBroker := cf.ServiceBroker{
 		Name:     c.Args()[0],
 		Username: c.Args()[1],
 		Password: c.Args()[2],
 		Url:      c.Args()[3],
 	}
-	cmd.ui.Say("Creating service broker %s...", terminal.EntityNameColor(serviceBroker.Name))
+	cmd.ui.Status(i18n.T("create_service_broker.creating"), terminal.EntityNameColor(serviceBroker.Name))
 
 	apiResponse := cmd.serviceBrokerRepo.Create(serviceBroker)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -2,6 +2,7 @@ package servicebroker
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -21,7 +22,7 @@ func NewDeleteServiceBroker(ui terminal.UI, repo api.ServiceBrokerRepository) (c
 
 func (cmd DeleteServiceBroker) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 1 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "delete-service-broker")
 		return
 	}
@@ -30,13 +31,13 @@ func (cmd DeleteServiceBroker) GetRequirements(reqFactory requirements.Factory,
 
 	return
 }
-func (cmd DeleteServiceBroker) Run(c *cli.Context) {
+func (cmd DeleteServiceBroker) Run(c *cli.Context) (err error) {
 	brokerName := c.Args()[0]
 	force := c.Bool("f")
 
 	if !force {
 		response := cmd.ui.Confirm(
-			"Really delete %s?%s",
+			i18n.T("delete_service_broker.confirm"),
 			terminal.EntityNameColor(brokerName),
 			terminal.PromptColor(">"),
 		)
@@ -45,25 +46,25 @@ func (cmd DeleteServiceBroker) Run(c *cli.Context) {
 		}
 	}
 
-	cmd.ui.Say("Deleting service broker %s...", terminal.EntityNameColor(brokerName))
+	cmd.ui.Status(i18n.T("delete_service_broker.deleting"), terminal.EntityNameColor(brokerName))
 
 	broker, apiResponse := cmd.repo.FindByName(brokerName)
 
 	if apiResponse.IsError() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	if apiResponse.IsNotFound() {
 		cmd.ui.Ok()
-		cmd.ui.Warn("Service Broker %s does not exist.", brokerName)
+		cmd.ui.Warn(i18n.T("delete_service_broker.broker_not_found"), brokerName)
 		return
 	}
 
 	apiResponse = cmd.repo.Delete(broker)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
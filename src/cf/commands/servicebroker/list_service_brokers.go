@@ -2,6 +2,7 @@ package servicebroker
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -22,21 +23,21 @@ func (cmd ListServiceBrokers) GetRequirements(reqFactory requirements.Factory, c
 	return
 }
 
-func (cmd ListServiceBrokers) Run(c *cli.Context) {
-	cmd.ui.Say("Getting service brokers...")
+func (cmd ListServiceBrokers) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("list_service_brokers.getting_brokers"))
 
 	serviceBrokers, apiResponse := cmd.repo.FindAll()
 
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.Say("")
+	cmd.ui.Status("")
 
 	if len(serviceBrokers) == 0 {
-		cmd.ui.Say("No service brokers found")
+		cmd.ui.Say(i18n.T("list_service_brokers.no_brokers"))
 		return
 	}
 
@@ -51,6 +52,5 @@ func (cmd ListServiceBrokers) Run(c *cli.Context) {
 		})
 	}
 
-	cmd.ui.DisplayTable(table)
-	return
+	return cmd.ui.DisplayTable(table)
 }
@@ -2,6 +2,7 @@ package servicebroker
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -21,7 +22,7 @@ func NewRenameServiceBroker(ui terminal.UI, repo api.ServiceBrokerRepository) (c
 
 func (cmd RenameServiceBroker) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "rename-service-broker")
 		return
 	}
@@ -31,14 +32,14 @@ func (cmd RenameServiceBroker) GetRequirements(reqFactory requirements.Factory,
 	return
 }
 
-func (cmd RenameServiceBroker) Run(c *cli.Context) {
+func (cmd RenameServiceBroker) Run(c *cli.Context) (err error) {
 	serviceBroker, apiResponse := cmd.repo.FindByName(c.Args()[0])
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
-	cmd.ui.Say("Renaming service broker %s...", terminal.EntityNameColor(serviceBroker.Name))
+	cmd.ui.Status(i18n.T("rename_service_broker.renaming"), terminal.EntityNameColor(serviceBroker.Name))
 
 	serviceBroker.Name = c.Args()[1]
 
@@ -46,7 +47,9 @@ func (cmd RenameServiceBroker) Run(c *cli.Context) {
 
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -3,6 +3,7 @@ package space
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -22,7 +23,7 @@ func NewCreateSpace(ui terminal.UI, sR api.SpaceRepository) (cmd CreateSpace) {
 
 func (cmd CreateSpace) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) == 0 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "create-space")
 		return
 	}
@@ -34,21 +35,22 @@ func (cmd CreateSpace) GetRequirements(reqFactory requirements.Factory, c *cli.C
 	return
 }
 
-func (cmd CreateSpace) Run(c *cli.Context) {
+func (cmd CreateSpace) Run(c *cli.Context) (err error) {
 	spaceName := c.Args()[0]
-	cmd.ui.Say("Creating space %s...", terminal.EntityNameColor(spaceName))
+	cmd.ui.Status(i18n.T("create_space.creating_space"), terminal.EntityNameColor(spaceName))
 
 	apiResponse := cmd.spaceRepo.Create(spaceName)
 	if apiResponse.IsNotSuccessful() {
 		if apiResponse.ErrorCode == cf.SPACE_EXISTS {
 			cmd.ui.Ok()
-			cmd.ui.Say("Space %s already exists", spaceName)
+			cmd.ui.Warn(i18n.T("create_space.space_already_exists"), spaceName)
 			return
 		}
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.Say("\nTIP: Use '%s' to target new space", terminal.CommandColor(cf.Name+" target -s "+spaceName))
+	cmd.ui.Status(i18n.T("create_space.target_tip"), terminal.CommandColor(cf.Name+" target -s "+spaceName))
+	return
 }
@@ -4,6 +4,7 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -27,7 +28,7 @@ func NewDeleteSpace(ui terminal.UI, sR api.SpaceRepository, cR configuration.Con
 
 func (cmd *DeleteSpace) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 1 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "delete-space")
 		return
 	}
@@ -35,28 +36,28 @@ func (cmd *DeleteSpace) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *DeleteSpace) Run(c *cli.Context) {
+func (cmd *DeleteSpace) Run(c *cli.Context) (err error) {
 	spaceName := c.Args()[0]
 	force := c.Bool("f")
 
-	cmd.ui.Say("Deleting space %s...", terminal.EntityNameColor(spaceName))
+	cmd.ui.Status(i18n.T("delete_space.deleting_space"), terminal.EntityNameColor(spaceName))
 
 	space, apiResponse := cmd.spaceRepo.FindByName(spaceName)
 
 	if apiResponse.IsError() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	if apiResponse.IsNotFound() {
 		cmd.ui.Ok()
-		cmd.ui.Warn("Space %s does not exist.", spaceName)
+		cmd.ui.Warn(i18n.T("delete_space.space_not_found"), spaceName)
 		return
 	}
 
 	if !force {
 		response := cmd.ui.Confirm(
-			"Really delete space %s and everything associated with it?%s",
+			i18n.T("delete_space.confirm"),
 			terminal.EntityNameColor(spaceName),
 			terminal.PromptColor(">"),
 		)
@@ -68,7 +69,7 @@ func (cmd *DeleteSpace) Run(c *cli.Context) {
 	apiResponse = cmd.spaceRepo.Delete(space)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
@@ -82,7 +83,7 @@ func (cmd *DeleteSpace) Run(c *cli.Context) {
 	if config.Space.Name == spaceName {
 		config.Space = cf.Space{}
 		cmd.configRepo.Save()
-		cmd.ui.Say("TIP: No space targeted, use '%s target -s' to target a space", cf.Name)
+		cmd.ui.Status(i18n.T("delete_space.no_space_targeted_tip"), cf.Name)
 	}
 
 	return
@@ -3,6 +3,7 @@ package space
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -29,18 +30,23 @@ func (cmd ListSpaces) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 	return
 }
 
-func (cmd ListSpaces) Run(c *cli.Context) {
-	cmd.ui.Say("Getting spaces in %s...", terminal.EntityNameColor(cmd.config.Organization.Name))
+func (cmd ListSpaces) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("list_spaces.getting_spaces"), terminal.EntityNameColor(cmd.config.Organization.Name))
 
 	spaces, apiResponse := cmd.spaceRepo.FindAll()
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	err = cmd.ui.Render(spaces)
+	if err != nil {
+		return
+	}
 
 	for _, space := range spaces {
 		cmd.ui.Say(space.Name)
 	}
+	return
 }
@@ -3,6 +3,7 @@ package space
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -28,7 +29,7 @@ func NewRenameSpace(ui terminal.UI, spaceRepo api.SpaceRepository, configRepo co
 
 func (cmd *RenameSpace) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "rename-space")
 		return
 	}
@@ -40,15 +41,15 @@ func (cmd *RenameSpace) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *RenameSpace) Run(c *cli.Context) {
+func (cmd *RenameSpace) Run(c *cli.Context) (err error) {
 	space := cmd.spaceReq.GetSpace()
 	newName := c.Args()[1]
-	cmd.ui.Say("Renaming space %s...", terminal.EntityNameColor(space.Name))
+	cmd.ui.Status(i18n.T("rename_space.renaming"), terminal.EntityNameColor(space.Name))
 
 	apiResponse := cmd.spaceRepo.Rename(space, newName)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	if cmd.config.Space.Guid == space.Guid {
@@ -57,4 +58,5 @@ func (cmd *RenameSpace) Run(c *cli.Context) {
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -2,6 +2,7 @@ package space
 
 import (
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -29,28 +30,29 @@ func (cmd *ShowSpace) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 	return
 }
 
-func (cmd *ShowSpace) Run(c *cli.Context) {
+func (cmd *ShowSpace) Run(c *cli.Context) (err error) {
 	space := cmd.config.Space
-	cmd.ui.Say("Getting info for space %s...", terminal.EntityNameColor(space.Name))
+	cmd.ui.Status(i18n.T("show_space.getting_info"), terminal.EntityNameColor(space.Name))
 	cmd.ui.Ok()
 	cmd.ui.Say("%s:", terminal.EntityNameColor(space.Name))
-	cmd.ui.Say("  Org: %s", terminal.EntityNameColor(space.Organization.Name))
+	cmd.ui.Say(i18n.T("show_space.org"), terminal.EntityNameColor(space.Organization.Name))
 
 	apps := []string{}
 	for _, app := range space.Applications {
 		apps = append(apps, app.Name)
 	}
-	cmd.ui.Say("  Apps: %s", terminal.EntityNameColor(strings.Join(apps, ", ")))
+	cmd.ui.Say(i18n.T("show_space.apps"), terminal.EntityNameColor(strings.Join(apps, ", ")))
 
 	domains := []string{}
 	for _, domain := range space.Domains {
 		domains = append(domains, domain.Name)
 	}
-	cmd.ui.Say("  Domains: %s", terminal.EntityNameColor(strings.Join(domains, ", ")))
+	cmd.ui.Say(i18n.T("show_space.domains"), terminal.EntityNameColor(strings.Join(domains, ", ")))
 
 	services := []string{}
 	for _, service := range space.ServiceInstances {
 		services = append(services, service.Name)
 	}
-	cmd.ui.Say("  Services: %s", terminal.EntityNameColor(strings.Join(services, ", ")))
+	cmd.ui.Say(i18n.T("show_space.services"), terminal.EntityNameColor(strings.Join(services, ", ")))
+	return
 }
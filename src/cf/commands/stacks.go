@@ -2,6 +2,7 @@ package commands
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -23,13 +24,13 @@ func (cmd *Stacks) GetRequirements(reqFactory requirements.Factory, c *cli.Conte
 	return
 }
 
-func (cmd *Stacks) Run(c *cli.Context) {
-	cmd.ui.Say("Getting stacks...")
+func (cmd *Stacks) Run(c *cli.Context) (err error) {
+	cmd.ui.Status(i18n.T("stacks.getting_stacks"))
 
 	stacks, apiResponse := cmd.stacksRepo.FindAll()
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
@@ -45,5 +46,5 @@ func (cmd *Stacks) Run(c *cli.Context) {
 		})
 	}
 
-	cmd.ui.DisplayTable(table)
+	return cmd.ui.DisplayTable(table)
 }
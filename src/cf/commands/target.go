@@ -4,6 +4,7 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -34,7 +35,7 @@ func NewTarget(ui terminal.UI,
 
 func (cmd Target) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 0 {
-		err = errors.New("incorrect usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "target")
 		return
 	}
@@ -45,7 +46,7 @@ func (cmd Target) GetRequirements(reqFactory requirements.Factory, c *cli.Contex
 	return
 }
 
-func (cmd Target) Run(c *cli.Context) {
+func (cmd Target) Run(c *cli.Context) (err error) {
 	orgName := c.String("o")
 	spaceName := c.String("s")
 	shouldShowTarget := (orgName == "" && spaceName == "")
@@ -54,31 +55,27 @@ func (cmd Target) Run(c *cli.Context) {
 		cmd.ui.ShowConfiguration(cmd.config)
 
 		if !cmd.config.HasOrganization() {
-			cmd.ui.Say("No org targeted, use '%s target -o' to target an org", cf.Name)
+			cmd.ui.Say(i18n.T("target.no_org_targeted"), cf.Name)
 		}
 		if !cmd.config.HasSpace() {
-			cmd.ui.Say("No space targeted, use '%s target -s' to target a space", cf.Name)
+			cmd.ui.Say(i18n.T("target.no_space_targeted"), cf.Name)
 		}
 		return
 	}
 
 	if orgName != "" {
-		err := cmd.setOrganization(orgName)
-
-		if spaceName == "" && cmd.config.IsLoggedIn() {
-			cmd.showConfig()
-			cmd.ui.Say("No space targeted, use '%s target -s' to target a space", cf.Name)
+		err = cmd.setOrganization(orgName)
+		if err != nil {
 			return
 		}
 
-		if err != nil {
-			return
+		if spaceName == "" {
+			return cmd.selectSpace()
 		}
 	}
 
 	if spaceName != "" {
-		err := cmd.setSpace(spaceName)
-
+		err = cmd.setSpace(spaceName)
 		if err != nil {
 			return
 		}
@@ -89,51 +86,74 @@ func (cmd Target) Run(c *cli.Context) {
 
 func (cmd Target) setOrganization(orgName string) (err error) {
 	if !cmd.config.IsLoggedIn() {
-		cmd.ui.Failed("You must be logged in to target an org. Use '%s login'.", cf.Name)
+		err = cf.NewCommandError(cf.AuthError, i18n.T("target.login_required_for_org"), cf.Name)
+		cmd.ui.Failed(err.Error())
 		return
 	}
 
 	org, apiResponse := cmd.orgRepo.FindByName(orgName)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Could not target org.\n%s", apiResponse.Message)
-		return
+		cmd.ui.Failed(i18n.T("target.could_not_target_org"), apiResponse.Message)
+		return apiResponse.AsError()
 	}
 
 	cmd.config.Organization = org
 	cmd.config.Space = cf.Space{}
-	cmd.saveConfig()
-	return
+	return cmd.saveConfig()
 }
 
 func (cmd Target) setSpace(spaceName string) (err error) {
 	if !cmd.config.IsLoggedIn() {
-		cmd.ui.Failed("You must be logged in to set a space. Use '%s login'.", cf.Name)
+		err = cf.NewCommandError(cf.AuthError, i18n.T("target.login_required_for_space"), cf.Name)
+		cmd.ui.Failed(err.Error())
 		return
 	}
 
 	if !cmd.config.HasOrganization() {
-		cmd.ui.Failed("An org must be targeted before targeting a space")
+		err = cf.NewCommandError(cf.GeneralError, "%s", i18n.T("target.org_required_for_space"))
+		cmd.ui.Failed(err.Error())
 		return
 	}
 
 	space, apiResponse := cmd.spaceRepo.FindByName(spaceName)
 
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Unable to access space %s.\n%s", spaceName, apiResponse.Message)
-		return
+		cmd.ui.Failed(i18n.T("target.could_not_target_space"), spaceName, apiResponse.Message)
+		return apiResponse.AsError()
 	}
 
 	cmd.config.Space = space
-	cmd.saveConfig()
+	return cmd.saveConfig()
+}
+
+func (cmd Target) selectSpace() (err error) {
+	selector := targetSelector{ui: cmd.ui, config: cmd.config, orgRepo: cmd.orgRepo, spaceRepo: cmd.spaceRepo}
+
+	spaceSelected, err := selector.selectSpace()
+	if err != nil {
+		return
+	}
+
+	if spaceSelected {
+		err = cmd.saveConfig()
+		if err != nil {
+			return
+		}
+	}
+
+	cmd.showConfig()
+	if !spaceSelected {
+		cmd.ui.Say(i18n.T("target.no_space_targeted"), cf.Name)
+	}
 	return
 }
 
-func (cmd Target) saveConfig() {
-	err := cmd.configRepo.Save()
+func (cmd Target) saveConfig() (err error) {
+	err = cmd.configRepo.Save()
 	if err != nil {
 		cmd.ui.Failed(err.Error())
-		return
 	}
+	return
 }
 
 func (cmd Target) showConfig() {
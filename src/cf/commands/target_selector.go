@@ -0,0 +1,135 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/i18n"
+	"cf/terminal"
+	"strconv"
+	"strings"
+)
+
+const maxSelectionTries = 3
+
+// Offers the orgs and spaces the user can access as numbered choices, for
+// login and target when no name was given. A single choice is picked
+// automatically, and pressing Enter skips the selection.
+type targetSelector struct {
+	ui        terminal.UI
+	config    *configuration.Configuration
+	orgRepo   api.OrganizationRepository
+	spaceRepo api.SpaceRepository
+}
+
+// The translated messages for choosing an org or a space
+type choiceMessages struct {
+	title     string
+	prompt    string
+	notListed string
+}
+
+func (selector targetSelector) selectOrganization() (selected bool, err error) {
+	orgs, apiResponse := selector.orgRepo.FindAll()
+	if apiResponse.IsNotSuccessful() {
+		selector.ui.Failed(i18n.T("target_selector.error_finding_orgs"), apiResponse.Message)
+		return false, apiResponse.AsError()
+	}
+
+	names := []string{}
+	for _, org := range orgs {
+		names = append(names, org.Name)
+	}
+
+	index := selector.choose(names, choiceMessages{
+		title:     i18n.T("target_selector.select_org"),
+		prompt:    i18n.T("target_selector.org_prompt"),
+		notListed: i18n.T("target_selector.org_not_listed"),
+	})
+	if index < 0 {
+		return
+	}
+
+	selector.ui.Status(i18n.T("target_selector.targeted_org"), terminal.EntityNameColor(orgs[index].Name))
+	selector.config.Organization = orgs[index]
+	selector.config.Space = cf.Space{}
+	return true, nil
+}
+
+func (selector targetSelector) selectSpace() (selected bool, err error) {
+	spaces, apiResponse := selector.spaceRepo.FindAll()
+	if apiResponse.IsNotSuccessful() {
+		selector.ui.Failed(i18n.T("target_selector.error_finding_spaces"), apiResponse.Message)
+		return false, apiResponse.AsError()
+	}
+
+	names := []string{}
+	for _, space := range spaces {
+		names = append(names, space.Name)
+	}
+
+	index := selector.choose(names, choiceMessages{
+		title:     i18n.T("target_selector.select_space"),
+		prompt:    i18n.T("target_selector.space_prompt"),
+		notListed: i18n.T("target_selector.space_not_listed"),
+	})
+	if index < 0 {
+		return
+	}
+
+	selector.ui.Status(i18n.T("target_selector.targeted_space"), terminal.EntityNameColor(spaces[index].Name))
+	selector.config.Space = spaces[index]
+	return true, nil
+}
+
+// Returns the index of the chosen name, or -1 when there is nothing to choose
+// from, the user skipped, or there is no terminal to ask on.
+func (selector targetSelector) choose(names []string, messages choiceMessages) (index int) {
+	switch {
+	case len(names) == 0:
+		return -1
+	case len(names) == 1:
+		return 0
+	case !selector.ui.IsInteractive():
+		return -1
+	}
+
+	selector.ui.Status("")
+	selector.ui.Status(messages.title)
+	for i, name := range names {
+		selector.ui.Status("%d. %s", i+1, name)
+	}
+
+	for i := 0; i < maxSelectionTries; i++ {
+		answer := strings.TrimSpace(selector.ui.Ask(messages.prompt, terminal.PromptColor(">")))
+		if answer == "" {
+			return -1
+		}
+
+		index = choiceIndex(answer, names)
+		if index >= 0 {
+			return
+		}
+
+		selector.ui.Warn(messages.notListed, answer)
+	}
+	return -1
+}
+
+// Accepts either the number shown next to a name or the name itself.
+func choiceIndex(answer string, names []string) int {
+	number, err := strconv.Atoi(answer)
+	if err == nil {
+		if number >= 1 && number <= len(names) {
+			return number - 1
+		}
+		return -1
+	}
+
+	for i, name := range names {
+		if strings.EqualFold(name, answer) {
+			return i
+		}
+	}
+	return -1
+}
@@ -265,6 +265,49 @@ func TestTargetOrganizationAndSpaceWhenSpaceFails(t *testing.T) {
 
 // End test with org and space options
 
+func TestTargetOrganizationOffersItsSpaces(t *testing.T) {
+	orgRepo, spaceRepo, configRepo, reqFactory := getTargetDependencies()
+	configRepo.Login()
+
+	orgRepo.FindByNameOrganization = cf.Organization{Name: "my-organization", Guid: "my-organization-guid"}
+	spaceRepo.Spaces = []cf.Space{
+		cf.Space{Name: "development", Guid: "development-guid"},
+		cf.Space{Name: "production", Guid: "production-guid"},
+	}
+
+	ui := &testterm.FakeUI{Inputs: []string{"2"}}
+	cmd := NewTarget(ui, configRepo, orgRepo, spaceRepo)
+	testcmd.RunCommand(cmd, testcmd.NewContext("target", []string{"-o", "my-organization"}), reqFactory)
+
+	assert.Contains(t, ui.DumpOutputs(), "1. development")
+	assert.Contains(t, ui.DumpOutputs(), "2. production")
+	assert.Equal(t, len(ui.Prompts), 1)
+
+	config, _ := configRepo.Get()
+	assert.Equal(t, config.Organization.Guid, "my-organization-guid")
+	assert.Equal(t, config.Space.Guid, "production-guid")
+	assert.Contains(t, ui.DumpOutputs(), "space:           production")
+}
+
+func TestTargetOrganizationSkippingSpaceSelection(t *testing.T) {
+	orgRepo, spaceRepo, configRepo, reqFactory := getTargetDependencies()
+	configRepo.Login()
+
+	orgRepo.FindByNameOrganization = cf.Organization{Name: "my-organization", Guid: "my-organization-guid"}
+	spaceRepo.Spaces = []cf.Space{
+		cf.Space{Name: "development", Guid: "development-guid"},
+		cf.Space{Name: "production", Guid: "production-guid"},
+	}
+
+	ui := &testterm.FakeUI{Inputs: []string{""}}
+	cmd := NewTarget(ui, configRepo, orgRepo, spaceRepo)
+	testcmd.RunCommand(cmd, testcmd.NewContext("target", []string{"-o", "my-organization"}), reqFactory)
+
+	config, _ := configRepo.Get()
+	assert.Equal(t, config.Space.Guid, "")
+	assert.Contains(t, ui.DumpOutputs(), "No space targeted")
+}
+
 func callTarget(args []string,
 	reqFactory *testreq.FakeReqFactory,
 	configRepo configuration.ConfigurationRepository,
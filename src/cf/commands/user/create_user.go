@@ -3,6 +3,7 @@ package user
 import (
 	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -22,20 +23,20 @@ func NewCreateUser(ui terminal.UI, userRepo api.UserRepository) (cmd CreateUser)
 
 func (cmd CreateUser) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 2 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "create-user")
 	}
 
-	reqs = append(reqs, reqFactory.NewLoginRequirement())
+	reqs = append(reqs, reqFactory.NewLoginRequirement(), reqFactory.NewAdminScopeRequirement())
 
 	return
 }
 
-func (cmd CreateUser) Run(c *cli.Context) {
+func (cmd CreateUser) Run(c *cli.Context) (err error) {
 	username := c.Args()[0]
 	password := c.Args()[1]
 
-	cmd.ui.Say("Creating user %s...", username)
+	cmd.ui.Status(i18n.T("create_user.creating_user"), username)
 
 	user := cf.User{
 		Username: username,
@@ -43,11 +44,12 @@ func (cmd CreateUser) Run(c *cli.Context) {
 	}
 	apiResponse := cmd.userRepo.Create(user)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Error creating user %s.\n%s", terminal.EntityNameColor(username), apiResponse.Message)
-		return
+		cmd.ui.Failed(i18n.T("create_user.error_creating_user"), terminal.EntityNameColor(username), apiResponse.Message)
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
 
-	cmd.ui.Say("\nTIP: Assign roles with '%s set-org-role' and '%s set-space-role'", cf.Name, cf.Name)
+	cmd.ui.Status(i18n.T("create_user.roles_tip"), cf.Name, cf.Name)
+	return
 }
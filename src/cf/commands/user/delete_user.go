@@ -2,6 +2,7 @@ package user
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -21,45 +22,46 @@ func NewDeleteUser(ui terminal.UI, userRepo api.UserRepository) (cmd DeleteUser)
 
 func (cmd DeleteUser) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 1 {
-		err = errors.New("Invalid usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "delete-user")
 		return
 	}
 
-	reqs = append(reqs, reqFactory.NewLoginRequirement())
+	reqs = append(reqs, reqFactory.NewLoginRequirement(), reqFactory.NewAdminScopeRequirement())
 
 	return
 }
 
-func (cmd DeleteUser) Run(c *cli.Context) {
+func (cmd DeleteUser) Run(c *cli.Context) (err error) {
 	username := c.Args()[0]
 	force := c.Bool("f")
 
-	if !force && !cmd.ui.Confirm("Really delete user %s?%s",
+	if !force && !cmd.ui.Confirm(i18n.T("delete_user.confirm"),
 		terminal.EntityNameColor(username),
 		terminal.PromptColor(">"),
 	) {
 		return
 	}
 
-	cmd.ui.Say("Deleting user %s...", terminal.EntityNameColor(username))
+	cmd.ui.Status(i18n.T("delete_user.deleting_user"), terminal.EntityNameColor(username))
 
 	user, apiResponse := cmd.userRepo.FindByUsername(username)
 	if apiResponse.IsError() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 	if apiResponse.IsNotFound() {
 		cmd.ui.Ok()
-		cmd.ui.Warn("User %s does not exist.", username)
+		cmd.ui.Warn(i18n.T("delete_user.user_not_found"), username)
 		return
 	}
 
 	apiResponse = cmd.userRepo.Delete(user)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -2,6 +2,7 @@ package user
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -24,7 +25,7 @@ func NewSetOrgRole(ui terminal.UI, userRepo api.UserRepository) (cmd *SetOrgRole
 
 func (cmd *SetOrgRole) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 3 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "set-org-role")
 		return
 	}
@@ -41,12 +42,12 @@ func (cmd *SetOrgRole) GetRequirements(reqFactory requirements.Factory, c *cli.C
 	return
 }
 
-func (cmd *SetOrgRole) Run(c *cli.Context) {
+func (cmd *SetOrgRole) Run(c *cli.Context) (err error) {
 	user := cmd.userReq.GetUser()
 	org := cmd.orgReq.GetOrganization()
 	role := c.Args()[2]
 
-	cmd.ui.Say("Assigning %s role to %s in %s org...",
+	cmd.ui.Status(i18n.T("set_org_role.assigning_role"),
 		terminal.EntityNameColor(role),
 		terminal.EntityNameColor(user.Username),
 		terminal.EntityNameColor(org.Name),
@@ -55,8 +56,9 @@ func (cmd *SetOrgRole) Run(c *cli.Context) {
 	apiResponse := cmd.userRepo.SetOrgRole(user, org, role)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
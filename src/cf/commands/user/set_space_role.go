@@ -2,6 +2,7 @@ package user
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -26,7 +27,7 @@ func NewSetSpaceRole(ui terminal.UI, spaceRepo api.SpaceRepository, userRepo api
 
 func (cmd *SetSpaceRole) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 4 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "set-space-role")
 		return
 	}
@@ -42,7 +43,7 @@ func (cmd *SetSpaceRole) GetRequirements(reqFactory requirements.Factory, c *cli
 	return
 }
 
-func (cmd *SetSpaceRole) Run(c *cli.Context) {
+func (cmd *SetSpaceRole) Run(c *cli.Context) (err error) {
 	spaceName := c.Args()[2]
 	role := c.Args()[3]
 
@@ -51,10 +52,10 @@ func (cmd *SetSpaceRole) Run(c *cli.Context) {
 	space, apiResponse := cmd.spaceRepo.FindByNameInOrg(spaceName, org)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
-	cmd.ui.Say("Assigning %s role to %s in %s space in %s org...",
+	cmd.ui.Status(i18n.T("set_space_role.assigning_role"),
 		terminal.EntityNameColor(role),
 		terminal.EntityNameColor(user.Username),
 		terminal.EntityNameColor(space.Name),
@@ -64,8 +65,9 @@ func (cmd *SetSpaceRole) Run(c *cli.Context) {
 	apiResponse = cmd.userRepo.SetSpaceRole(user, space, role)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
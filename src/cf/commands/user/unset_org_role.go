@@ -2,6 +2,7 @@ package user
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -25,7 +26,7 @@ func NewUnsetOrgRole(ui terminal.UI, userRepo api.UserRepository) (cmd *UnsetOrg
 
 func (cmd *UnsetOrgRole) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 3 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "unset-org-role")
 		return
 	}
@@ -42,12 +43,12 @@ func (cmd *UnsetOrgRole) GetRequirements(reqFactory requirements.Factory, c *cli
 	return
 }
 
-func (cmd *UnsetOrgRole) Run(c *cli.Context) {
+func (cmd *UnsetOrgRole) Run(c *cli.Context) (err error) {
 	role := c.Args()[2]
 	user := cmd.userReq.GetUser()
 	org := cmd.orgReq.GetOrganization()
 
-	cmd.ui.Say("Removing %s role from %s in %s org...",
+	cmd.ui.Status(i18n.T("unset_org_role.removing_role"),
 		terminal.EntityNameColor(role),
 		terminal.EntityNameColor(c.Args()[0]),
 		terminal.EntityNameColor(c.Args()[1]),
@@ -57,8 +58,9 @@ func (cmd *UnsetOrgRole) Run(c *cli.Context) {
 
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
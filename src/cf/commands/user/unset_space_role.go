@@ -2,6 +2,7 @@ package user
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -26,7 +27,7 @@ func NewUnsetSpaceRole(ui terminal.UI, spaceRepo api.SpaceRepository, userRepo a
 
 func (cmd *UnsetSpaceRole) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	if len(c.Args()) != 4 {
-		err = errors.New("Incorrect Usage")
+		err = errors.New(i18n.T("terminal.incorrect_usage"))
 		cmd.ui.FailWithUsage(c, "unset-space-role")
 		return
 	}
@@ -43,7 +44,7 @@ func (cmd *UnsetSpaceRole) GetRequirements(reqFactory requirements.Factory, c *c
 	return
 }
 
-func (cmd *UnsetSpaceRole) Run(c *cli.Context) {
+func (cmd *UnsetSpaceRole) Run(c *cli.Context) (err error) {
 	spaceName := c.Args()[2]
 	role := c.Args()[3]
 
@@ -52,10 +53,10 @@ func (cmd *UnsetSpaceRole) Run(c *cli.Context) {
 	space, apiResponse := cmd.spaceRepo.FindByNameInOrg(spaceName, org)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
-	cmd.ui.Say("Removing %s role from %s in %s space in %s org...",
+	cmd.ui.Status(i18n.T("unset_space_role.removing_role"),
 		terminal.EntityNameColor(role),
 		terminal.EntityNameColor(user.Username),
 		terminal.EntityNameColor(space.Name),
@@ -66,8 +67,9 @@ func (cmd *UnsetSpaceRole) Run(c *cli.Context) {
 
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Failed(apiResponse.Message)
-		return
+		return apiResponse.AsError()
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"cf"
+	"cf/configuration"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+	"strings"
+	"time"
+)
+
+const tokenExpiryFormat = "2006-01-02 03:04:05 PM MST"
+
+type Whoami struct {
+	ui     terminal.UI
+	config *configuration.Configuration
+}
+
+func NewWhoami(ui terminal.UI, config *configuration.Configuration) (cmd Whoami) {
+	cmd.ui = ui
+	cmd.config = config
+	return
+}
+
+func (cmd Whoami) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd Whoami) Run(c *cli.Context) (err error) {
+	info, err := cmd.config.TokenInfo()
+	if err != nil {
+		err = cf.NewCommandError(cf.GeneralError, i18n.T("whoami.could_not_read_token"), err.Error())
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	if info.Username != "" {
+		cmd.ui.Say(i18n.T("whoami.user"), terminal.EntityNameColor(info.Username))
+		cmd.ui.Say(i18n.T("whoami.email"), terminal.EntityNameColor(info.Email))
+		cmd.ui.Say(i18n.T("whoami.user_id"), terminal.EntityNameColor(info.UserGuid))
+	}
+	cmd.ui.Say(i18n.T("whoami.client"), terminal.EntityNameColor(info.ClientId))
+	cmd.ui.Say(i18n.T("whoami.issuer"), terminal.EntityNameColor(info.Issuer))
+	cmd.ui.Say(i18n.T("whoami.scopes"), terminal.EntityNameColor(strings.Join(info.Scopes, ", ")))
+
+	expiresAt := info.ExpiresAt()
+	if info.Expiry == 0 {
+		cmd.ui.Say(i18n.T("whoami.never_expires"))
+	} else if expiresAt.Before(time.Now()) {
+		cmd.ui.Say(i18n.T("whoami.expired"), expiresAt.Local().Format(tokenExpiryFormat))
+	} else {
+		cmd.ui.Say(i18n.T("whoami.expires"), expiresAt.Local().Format(tokenExpiryFormat))
+	}
+
+	warnAboutMissingScopes(cmd.ui, info)
+	return
+}
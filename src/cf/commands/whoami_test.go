@@ -0,0 +1,79 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"cf/configuration"
+	"encoding/base64"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+	"testing"
+)
+
+func TestWhoamiRequirements(t *testing.T) {
+	config := &configuration.Configuration{}
+
+	reqFactory := &testreq.FakeReqFactory{LoginSuccess: true}
+	callWhoami(config, reqFactory)
+	assert.True(t, testcmd.CommandDidPassRequirements)
+
+	reqFactory = &testreq.FakeReqFactory{LoginSuccess: false}
+	callWhoami(config, reqFactory)
+	assert.False(t, testcmd.CommandDidPassRequirements)
+}
+
+func TestWhoamiShowsTheTokenDetails(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+	config := configRepo.Login()
+
+	ui := callWhoami(config, &testreq.FakeReqFactory{LoginSuccess: true})
+
+	assert.Contains(t, ui.Outputs[0], "user1@example.com")
+	assert.Contains(t, ui.Outputs[2], "772dda3f-669f-4276-b2bd-90486abe1f6f")
+	assert.Contains(t, ui.Outputs[3], "cf")
+	assert.Contains(t, ui.Outputs[4], "https://uaa.arborglen.cf-app.com/oauth/token")
+	assert.Contains(t, ui.Outputs[5], "cloud_controller.read, cloud_controller.write, openid, password.write")
+	assert.Contains(t, ui.Outputs[6], "expired")
+}
+
+func TestWhoamiListsTheAdminScopeAsNeededForAdminCommands(t *testing.T) {
+	configRepo := testconfig.FakeConfigRepository{}
+	configRepo.Delete()
+	config := configRepo.Login()
+
+	ui := callWhoami(config, &testreq.FakeReqFactory{LoginSuccess: true})
+
+	assert.NotContains(t, ui.DumpOutputs(), "Missing scope")
+	assert.Contains(t, ui.DumpOutputs(), "Scope cloud_controller.admin is not granted, it is only needed for admin commands")
+}
+
+func TestWhoamiWarnsAboutMissingScopes(t *testing.T) {
+	payload := strings.TrimRight(base64.URLEncoding.EncodeToString([]byte(`{"user_name":"user1@example.com","scope":["cloud_controller.read","cloud_controller.admin"]}`)), "=")
+	config := &configuration.Configuration{AccessToken: "bearer eyJhbGciOiJSUzI1NiJ9." + payload + ".signature"}
+
+	ui := callWhoami(config, &testreq.FakeReqFactory{LoginSuccess: true})
+
+	assert.Contains(t, ui.DumpOutputs(), "Missing scope cloud_controller.write")
+	assert.NotContains(t, ui.DumpOutputs(), "Missing scope cloud_controller.read")
+	assert.NotContains(t, ui.DumpOutputs(), "not granted")
+}
+
+func TestWhoamiWithAnInvalidToken(t *testing.T) {
+	config := &configuration.Configuration{AccessToken: "bearer not-a-jwt"}
+
+	ui := callWhoami(config, &testreq.FakeReqFactory{LoginSuccess: true})
+
+	assert.Equal(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Could not read the access token")
+}
+
+func callWhoami(config *configuration.Configuration, reqFactory *testreq.FakeReqFactory) (ui *testterm.FakeUI) {
+	ui = new(testterm.FakeUI)
+	cmd := NewWhoami(ui, config)
+	testcmd.RunCommand(cmd, testcmd.NewContext("whoami", []string{}), reqFactory)
+	return
+}
@@ -0,0 +1,91 @@
+package configuration
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"time"
+)
+
+// Names are only completed from the cache for a short while, so that new and
+// deleted apps show up without the cache having to be cleared.
+const CompletionCacheTTL = 5 * time.Minute
+
+// The kinds of names that are cached
+const (
+	CompletionApps     = "apps"
+	CompletionServices = "services"
+	CompletionSpaces   = "spaces"
+	CompletionOrgs     = "orgs"
+)
+
+type CompletionCache interface {
+	Names(kind, scope string) (names []string, found bool)
+	Save(kind, scope string, names []string) (err error)
+}
+
+type completionCacheEntry struct {
+	Scope     string
+	Names     []string
+	UpdatedAt time.Time
+}
+
+type CompletionDiskCache struct {
+	path string
+}
+
+func NewCompletionDiskCache(path string) (cache CompletionDiskCache) {
+	cache.path = path
+	return
+}
+
+func CompletionCacheFile() (file string, err error) {
+	return configDirFile("completion_cache.json")
+}
+
+// Entries are kept per kind of name, and only match the scope they were saved
+// for, such as the space the app names were listed in.
+func (cache CompletionDiskCache) Names(kind, scope string) (names []string, found bool) {
+	entry, found := cache.load()[kind]
+	if !found || entry.Scope != scope || time.Since(entry.UpdatedAt) > CompletionCacheTTL {
+		return nil, false
+	}
+	return entry.Names, true
+}
+
+func (cache CompletionDiskCache) Save(kind, scope string, names []string) (err error) {
+	entries := cache.load()
+	entries[kind] = completionCacheEntry{Scope: scope, Names: names, UpdatedAt: time.Now()}
+
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return
+	}
+	return writeFileAtomically(cache.path, data, filePermissions)
+}
+
+// Cached names are only valid for the space, org or user they were listed for.
+func CompletionScope(config *Configuration, kind string) string {
+	switch kind {
+	case CompletionApps, CompletionServices:
+		return config.Space.Guid
+	case CompletionSpaces:
+		return config.Organization.Guid
+	}
+	return config.Target + " " + config.UserGuid()
+}
+
+// A missing or unreadable cache is treated as empty.
+func (cache CompletionDiskCache) load() (entries map[string]completionCacheEntry) {
+	entries = map[string]completionCacheEntry{}
+
+	data, err := ioutil.ReadFile(cache.path)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(data, &entries)
+	if err != nil {
+		entries = map[string]completionCacheEntry{}
+	}
+	return
+}
@@ -0,0 +1,65 @@
+package configuration
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCompletionCacheSavesNamesPerKindAndScope(t *testing.T) {
+	withTempDir(t, func(dir string) {
+		cache := NewCompletionDiskCache(filepath.Join(dir, "completion_cache.json"))
+
+		_, found := cache.Names("apps", "my-space-guid")
+		assert.False(t, found)
+
+		err := cache.Save("apps", "my-space-guid", []string{"app-1", "app-2"})
+		assert.NoError(t, err)
+		err = cache.Save("orgs", "https://api.example.com", []string{"my-org"})
+		assert.NoError(t, err)
+
+		names, found := cache.Names("apps", "my-space-guid")
+		assert.True(t, found)
+		assert.Equal(t, names, []string{"app-1", "app-2"})
+
+		names, found = cache.Names("orgs", "https://api.example.com")
+		assert.True(t, found)
+		assert.Equal(t, names, []string{"my-org"})
+
+		_, found = cache.Names("apps", "other-space-guid")
+		assert.False(t, found)
+	})
+}
+
+func TestCompletionCacheExpires(t *testing.T) {
+	withTempDir(t, func(dir string) {
+		path := filepath.Join(dir, "completion_cache.json")
+		cache := NewCompletionDiskCache(path)
+
+		updatedAt := time.Now().Add(-CompletionCacheTTL - time.Minute).Format(time.RFC3339)
+		err := ioutil.WriteFile(path, []byte(`{"apps":{"Scope":"my-space-guid","Names":["app-1"],"UpdatedAt":"`+updatedAt+`"}}`), 0644)
+		assert.NoError(t, err)
+
+		_, found := cache.Names("apps", "my-space-guid")
+		assert.False(t, found)
+	})
+}
+
+func TestCompletionCacheIgnoresACorruptFile(t *testing.T) {
+	withTempDir(t, func(dir string) {
+		path := filepath.Join(dir, "completion_cache.json")
+		ioutil.WriteFile(path, []byte(`not json`), 0644)
+		cache := NewCompletionDiskCache(path)
+
+		_, found := cache.Names("apps", "my-space-guid")
+		assert.False(t, found)
+
+		err := cache.Save("apps", "my-space-guid", []string{"app-1"})
+		assert.NoError(t, err)
+
+		names, _ := cache.Names("apps", "my-space-guid")
+		assert.Equal(t, names, []string{"app-1"})
+	})
+}
@@ -2,23 +2,35 @@ package configuration
 
 import (
 	"cf"
-	"encoding/json"
 	"time"
 )
 
 type Configuration struct {
+	ConfigVersion           int
 	Target                  string
 	ApiVersion              string
 	AuthorizationEndpoint   string
-	AccessToken             string
-	RefreshToken            string
+	TokenEndpoint           string
+	LoggingEndpoint         string
+	AccessToken             string `json:",omitempty"`
+	RefreshToken            string `json:",omitempty"`
+	ClientId                string
+	ClientSecret            string `json:",omitempty"`
 	Organization            cf.Organization
 	Space                   cf.Space
 	ApplicationStartTimeout time.Duration // will be used as seconds
+
+	// Commands the user defined with 'cf config alias', each expanding to a
+	// command and its arguments, and default flag values by command and flag.
+	Aliases  map[string][]string          `json:",omitempty"`
+	Defaults map[string]map[string]string `json:",omitempty"`
+
+	// Set with 'cf config language', otherwise the language of the locale is used
+	Language string `json:",omitempty"`
 }
 
 func (c Configuration) UserEmail() (email string) {
-	info, err := c.getTokenInfo()
+	info, err := c.TokenInfo()
 
 	if err != nil {
 		return
@@ -28,7 +40,7 @@ func (c Configuration) UserEmail() (email string) {
 }
 
 func (c Configuration) UserGuid() (guid string) {
-	info, err := c.getTokenInfo()
+	info, err := c.TokenInfo()
 
 	if err != nil {
 		return
@@ -37,6 +49,28 @@ func (c Configuration) UserGuid() (guid string) {
 	return info.UserGuid
 }
 
+func (c Configuration) TokenInfo() (info TokenInfo, err error) {
+	return NewTokenInfo(c.AccessToken)
+}
+
+func (c Configuration) tokens() Tokens {
+	return Tokens{
+		AccessToken:  c.AccessToken,
+		RefreshToken: c.RefreshToken,
+		ClientSecret: c.ClientSecret,
+	}
+}
+
+func (c *Configuration) setTokens(tokens Tokens) {
+	c.AccessToken = tokens.AccessToken
+	c.RefreshToken = tokens.RefreshToken
+	c.ClientSecret = tokens.ClientSecret
+}
+
+func (c Configuration) IsClientLogin() bool {
+	return c.ClientId != ""
+}
+
 func (c Configuration) IsLoggedIn() bool {
 	return c.AccessToken != ""
 }
@@ -48,20 +82,3 @@ func (c Configuration) HasOrganization() bool {
 func (c Configuration) HasSpace() bool {
 	return c.Space.Guid != "" && c.Space.Name != ""
 }
-
-type tokenInfo struct {
-	UserName string `json:"user_name"`
-	Email    string `json:"email"`
-	UserGuid string `json:"user_id"`
-}
-
-func (c Configuration) getTokenInfo() (info tokenInfo, err error) {
-	clearInfo, err := DecodeTokenInfo(c.AccessToken)
-
-	if err != nil {
-		return
-	}
-	info = tokenInfo{}
-	err = json.Unmarshal(clearInfo, &info)
-	return
-}
@@ -0,0 +1,16 @@
+// +build darwin freebsd linux netbsd openbsd
+
+package configuration
+
+import (
+	"os"
+	"syscall"
+)
+
+func lockFile(file *os.File) (err error) {
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_EX)
+}
+
+func unlockFile(file *os.File) (err error) {
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+}
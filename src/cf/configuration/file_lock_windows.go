@@ -0,0 +1,35 @@
+// +build windows
+
+package configuration
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	kernel32         = syscall.NewLazyDLL("kernel32.dll")
+	procLockFileEx   = kernel32.NewProc("LockFileEx")
+	procUnlockFileEx = kernel32.NewProc("UnlockFileEx")
+)
+
+const lockfileExclusiveLock = 0x00000002
+
+func lockFile(file *os.File) (err error) {
+	overlapped := new(syscall.Overlapped)
+	result, _, callErr := procLockFileEx.Call(file.Fd(), lockfileExclusiveLock, 0, 1, 0, uintptr(unsafe.Pointer(overlapped)))
+	if result == 0 {
+		err = callErr
+	}
+	return
+}
+
+func unlockFile(file *os.File) (err error) {
+	overlapped := new(syscall.Overlapped)
+	result, _, callErr := procUnlockFileEx.Call(file.Fd(), 0, 1, 0, uintptr(unsafe.Pointer(overlapped)))
+	if result == 0 {
+		err = callErr
+	}
+	return
+}
@@ -0,0 +1,66 @@
+package configuration
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var fileLockMutex sync.Mutex
+
+// Holds an advisory lock on a file next to path while f runs, so that
+// concurrent cf processes do not interleave their writes.
+func withFileLock(path string, f func() error) (err error) {
+	fileLockMutex.Lock()
+	defer fileLockMutex.Unlock()
+
+	lock, err := os.OpenFile(path+".lock", os.O_CREATE|os.O_RDWR, filePermissions)
+	if err != nil {
+		return
+	}
+	defer lock.Close()
+
+	err = lockFile(lock)
+	if err != nil {
+		return
+	}
+	defer unlockFile(lock)
+
+	return f()
+}
+
+// Writes to a temporary file in the same directory and renames it over path,
+// so readers see either the old or the new contents but never a partial file.
+func writeFileAtomically(path string, data []byte, perm os.FileMode) (err error) {
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		if err != nil {
+			os.Remove(tempFile.Name())
+		}
+	}()
+
+	_, err = tempFile.Write(data)
+	if err == nil {
+		err = tempFile.Sync()
+	}
+	closeErr := tempFile.Close()
+	if err != nil {
+		return
+	}
+	if closeErr != nil {
+		err = closeErr
+		return
+	}
+
+	err = os.Chmod(tempFile.Name(), perm)
+	if err != nil {
+		return
+	}
+
+	return os.Rename(tempFile.Name(), path)
+}
@@ -0,0 +1,67 @@
+package configuration
+
+import (
+	"cf/i18n"
+	"fmt"
+	"strings"
+)
+
+const CurrentConfigVersion = 2
+
+type UnsupportedConfigVersionError struct {
+	Version int
+}
+
+func (err UnsupportedConfigVersionError) Error() string {
+	return fmt.Sprintf(i18n.T("migrations.unsupported_version"),
+		err.Version, CurrentConfigVersion)
+}
+
+type configMigration func(data map[string]interface{})
+
+// configMigrations[i] upgrades a config from version i to version i+1.
+// Config files written before versioning was introduced are version 0.
+var configMigrations = []configMigration{
+	addDefaultTimeouts,
+	normalizeEndpoints,
+}
+
+func migrateConfig(data map[string]interface{}) (migrated bool, err error) {
+	version := 0
+	if rawVersion, ok := data["ConfigVersion"].(float64); ok {
+		version = int(rawVersion)
+	}
+
+	if version > CurrentConfigVersion {
+		err = UnsupportedConfigVersionError{Version: version}
+		return
+	}
+
+	for ; version < CurrentConfigVersion; version++ {
+		configMigrations[version](data)
+		migrated = true
+	}
+
+	data["ConfigVersion"] = CurrentConfigVersion
+	return
+}
+
+func addDefaultTimeouts(data map[string]interface{}) {
+	if timeout, ok := data["ApplicationStartTimeout"].(float64); !ok || timeout <= 0 {
+		data["ApplicationStartTimeout"] = defaultConfig().ApplicationStartTimeout
+	}
+}
+
+func normalizeEndpoints(data map[string]interface{}) {
+	for _, key := range []string{"Target", "AuthorizationEndpoint"} {
+		endpoint, ok := data[key].(string)
+		if !ok || endpoint == "" {
+			continue
+		}
+
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "https://" + endpoint
+		}
+		data[key] = strings.TrimRight(endpoint, "/")
+	}
+}
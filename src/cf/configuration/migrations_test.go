@@ -0,0 +1,97 @@
+package configuration
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestMigratingConfigsWithoutAVersion(t *testing.T) {
+	data := map[string]interface{}{
+		"Target":                "api.example.com/",
+		"AuthorizationEndpoint": "https://login.example.com/",
+	}
+
+	migrated, err := migrateConfig(data)
+
+	assert.NoError(t, err)
+	assert.True(t, migrated)
+	assert.Equal(t, data["ConfigVersion"], CurrentConfigVersion)
+	assert.Equal(t, data["Target"], "https://api.example.com")
+	assert.Equal(t, data["AuthorizationEndpoint"], "https://login.example.com")
+	assert.Equal(t, data["ApplicationStartTimeout"], time.Duration(30))
+}
+
+func TestMigratingKeepsExistingTimeouts(t *testing.T) {
+	data := map[string]interface{}{"ApplicationStartTimeout": float64(120)}
+
+	_, err := migrateConfig(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, data["ApplicationStartTimeout"], float64(120))
+}
+
+func TestMigratingCurrentConfigsDoesNothing(t *testing.T) {
+	data := map[string]interface{}{
+		"ConfigVersion": float64(CurrentConfigVersion),
+		"Target":        "http://api.example.com/v2/",
+	}
+
+	migrated, err := migrateConfig(data)
+
+	assert.NoError(t, err)
+	assert.False(t, migrated)
+	assert.Equal(t, data["Target"], "http://api.example.com/v2/")
+}
+
+func TestMigratingRefusesFutureVersions(t *testing.T) {
+	data := map[string]interface{}{"ConfigVersion": float64(CurrentConfigVersion + 1)}
+
+	_, err := migrateConfig(data)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Please upgrade cf")
+}
+
+func TestLoadingUpgradesOldConfigFiles(t *testing.T) {
+	repo := NewConfigurationDiskRepository()
+	repo.loadDefaultConfig(t)
+	defer repo.restoreConfig(t)
+
+	configFile, err := ConfigFile()
+	assert.NoError(t, err)
+	err = ioutil.WriteFile(configFile, []byte(`{"Target":"api.example.com","ApiVersion":"2"}`), 0644)
+	assert.NoError(t, err)
+
+	singleton = nil
+	config, err := repo.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, config.ConfigVersion, CurrentConfigVersion)
+	assert.Equal(t, config.Target, "https://api.example.com")
+	assert.Equal(t, config.ApplicationStartTimeout, time.Duration(30))
+
+	data, err := ioutil.ReadFile(configFile)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"ConfigVersion":2`)
+}
+
+func TestLoadingRefusesConfigFilesFromTheFuture(t *testing.T) {
+	repo := NewConfigurationDiskRepository()
+	repo.loadDefaultConfig(t)
+	defer repo.restoreConfig(t)
+
+	configFile, err := ConfigFile()
+	assert.NoError(t, err)
+	futureConfig := []byte(`{"ConfigVersion":99,"Target":"https://api.example.com"}`)
+	err = ioutil.WriteFile(configFile, futureConfig, 0644)
+	assert.NoError(t, err)
+
+	singleton = nil
+	_, err = repo.Get()
+	assert.Equal(t, err, UnsupportedConfigVersionError{Version: 99})
+
+	data, err := ioutil.ReadFile(configFile)
+	assert.NoError(t, err)
+	assert.Equal(t, data, futureConfig)
+}
@@ -0,0 +1,191 @@
+package configuration
+
+import (
+	"cf"
+	"cf/i18n"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const DefaultProfile = "default"
+
+var validProfileName = regexp.MustCompile(`^[A-Za-z0-9_\-]+$`)
+
+// CF_PROFILE, or the profile chosen with 'cf profile use', names a profile that
+// cannot be used.
+type UnknownProfileError struct {
+	Name string
+}
+
+func (err UnknownProfileError) Error() string {
+	if invalidErr := validateProfileName(err.Name); invalidErr != nil {
+		return invalidErr.Error()
+	}
+	return fmt.Sprintf(i18n.T("profiles.unknown_profile"), err.Name, cf.Name, err.Name)
+}
+
+type ProfileRepository interface {
+	Current() (name string)
+	List() (names []string, err error)
+	Create(name string) (err error)
+	Use(name string) (err error)
+	Delete(name string) (err error)
+}
+
+type ProfileDiskRepository struct {
+}
+
+func NewProfileDiskRepository() (repo ProfileDiskRepository) {
+	return ProfileDiskRepository{}
+}
+
+func (repo ProfileDiskRepository) Current() (name string) {
+	return CurrentProfile()
+}
+
+func (repo ProfileDiskRepository) List() (names []string, err error) {
+	names = []string{DefaultProfile}
+
+	entries, err := ioutil.ReadDir(profilesDir())
+	if os.IsNotExist(err) {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	profileNames := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && entry.Name() != DefaultProfile {
+			profileNames = append(profileNames, entry.Name())
+		}
+	}
+	sort.Strings(profileNames)
+
+	names = append(names, profileNames...)
+	return
+}
+
+func (repo ProfileDiskRepository) Create(name string) (err error) {
+	err = validateProfileName(name)
+	if err != nil {
+		return
+	}
+
+	if profileExists(name) {
+		err = fmt.Errorf(i18n.T("profiles.already_exists"), name)
+		return
+	}
+
+	return os.MkdirAll(profileDir(name), dirPermissions)
+}
+
+func (repo ProfileDiskRepository) Use(name string) (err error) {
+	if !profileExists(name) {
+		err = fmt.Errorf(i18n.T("profiles.not_found"), name)
+		return
+	}
+
+	err = os.MkdirAll(configDir(), dirPermissions)
+	if err != nil {
+		return
+	}
+
+	err = ioutil.WriteFile(currentProfileFile(), []byte(name+"\n"), filePermissions)
+	if err != nil {
+		return
+	}
+
+	singleton = nil
+	return
+}
+
+func (repo ProfileDiskRepository) Delete(name string) (err error) {
+	if name == DefaultProfile {
+		err = errors.New(i18n.T("profiles.cannot_delete_default"))
+		return
+	}
+
+	if !profileExists(name) {
+		err = fmt.Errorf(i18n.T("profiles.not_found"), name)
+		return
+	}
+
+	if name == CurrentProfile() {
+		err = fmt.Errorf(i18n.T("profiles.in_use"), name)
+		return
+	}
+
+	return os.RemoveAll(profileDir(name))
+}
+
+// CF_PROFILE takes precedence over the profile chosen with 'cf profile use'
+func CurrentProfile() (name string) {
+	name = os.Getenv("CF_PROFILE")
+	if name != "" {
+		return
+	}
+
+	data, err := ioutil.ReadFile(currentProfileFile())
+	if err == nil {
+		name = strings.TrimSpace(string(data))
+	}
+
+	if name == "" {
+		name = DefaultProfile
+	}
+	return
+}
+
+// Fails with an UnknownProfileError when the current profile cannot be used
+func CheckCurrentProfile() (err error) {
+	return checkProfileExists(CurrentProfile())
+}
+
+func validateProfileName(name string) (err error) {
+	if !validProfileName.MatchString(name) {
+		err = fmt.Errorf(i18n.T("profiles.invalid_name"), name)
+	}
+	return
+}
+
+func checkProfileExists(name string) (err error) {
+	if !profileExists(name) {
+		err = UnknownProfileError{Name: name}
+	}
+	return
+}
+
+func profileExists(name string) bool {
+	if name == DefaultProfile {
+		return true
+	}
+	if validateProfileName(name) != nil {
+		return false
+	}
+
+	fileInfo, err := os.Stat(profileDir(name))
+	return err == nil && fileInfo.IsDir()
+}
+
+func profileDir(name string) string {
+	if name == DefaultProfile {
+		return configDir()
+	}
+	return filepath.Join(profilesDir(), name)
+}
+
+func profilesDir() string {
+	return filepath.Join(configDir(), "profiles")
+}
+
+func currentProfileFile() string {
+	return filepath.Join(configDir(), "profile")
+}
@@ -0,0 +1,159 @@
+package configuration
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func withTempCfHome(t *testing.T, f func(home string)) {
+	home, err := ioutil.TempDir("", "cf-home")
+	assert.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	oldHome := os.Getenv("CF_HOME")
+	oldProfile := os.Getenv("CF_PROFILE")
+	os.Setenv("CF_HOME", home)
+	os.Setenv("CF_PROFILE", "")
+	defer os.Setenv("CF_HOME", oldHome)
+	defer os.Setenv("CF_PROFILE", oldProfile)
+
+	singleton = nil
+	defer func() { singleton = nil }()
+
+	f(home)
+}
+
+func TestConfigFileRespectsCfHome(t *testing.T) {
+	withTempCfHome(t, func(home string) {
+		file, err := ConfigFile()
+
+		assert.NoError(t, err)
+		assert.Equal(t, file, filepath.Join(home, ".cf", "config.json"))
+	})
+}
+
+func TestCreatingAndListingProfiles(t *testing.T) {
+	withTempCfHome(t, func(home string) {
+		repo := NewProfileDiskRepository()
+
+		assert.NoError(t, repo.Create("staging"))
+		assert.NoError(t, repo.Create("production"))
+		assert.Error(t, repo.Create("staging"))
+		assert.Error(t, repo.Create("../escape"))
+
+		names, err := repo.List()
+		assert.NoError(t, err)
+		assert.Equal(t, names, []string{"default", "production", "staging"})
+		assert.Equal(t, repo.Current(), "default")
+	})
+}
+
+func TestUsingAProfileSwitchesTheConfiguration(t *testing.T) {
+	withTempCfHome(t, func(home string) {
+		profileRepo := NewProfileDiskRepository()
+		configRepo := NewConfigurationDiskRepository()
+
+		config, err := configRepo.Get()
+		assert.NoError(t, err)
+		config.Target = "https://api.default.example.com"
+		config.AccessToken = "bearer default_token"
+		assert.NoError(t, configRepo.Save())
+
+		assert.NoError(t, profileRepo.Create("staging"))
+		assert.NoError(t, profileRepo.Use("staging"))
+		assert.Equal(t, profileRepo.Current(), "staging")
+
+		config, err = configRepo.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, config.Target, "https://api.run.pivotal.io")
+		assert.Equal(t, config.AccessToken, "")
+
+		config.Target = "https://api.staging.example.com"
+		config.AccessToken = "bearer staging_token"
+		assert.NoError(t, configRepo.Save())
+
+		file, err := ConfigFile()
+		assert.NoError(t, err)
+		assert.Equal(t, file, filepath.Join(home, ".cf", "profiles", "staging", "config.json"))
+
+		assert.NoError(t, profileRepo.Use("default"))
+		config, err = configRepo.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, config.Target, "https://api.default.example.com")
+		assert.Equal(t, config.AccessToken, "bearer default_token")
+	})
+}
+
+func TestUsingAProfileThatDoesNotExist(t *testing.T) {
+	withTempCfHome(t, func(home string) {
+		repo := NewProfileDiskRepository()
+
+		err := repo.Use("missing")
+
+		assert.Error(t, err)
+		assert.Equal(t, repo.Current(), "default")
+	})
+}
+
+func TestCfProfileOverridesTheCurrentProfile(t *testing.T) {
+	withTempCfHome(t, func(home string) {
+		repo := NewProfileDiskRepository()
+		assert.NoError(t, repo.Create("staging"))
+
+		os.Setenv("CF_PROFILE", "staging")
+
+		assert.Equal(t, repo.Current(), "staging")
+	})
+}
+
+func TestCfProfileMustNameAnExistingProfile(t *testing.T) {
+	withTempCfHome(t, func(home string) {
+		os.Setenv("CF_PROFILE", "missing")
+
+		_, err := ConfigFile()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Profile missing does not exist")
+
+		_, err = os.Stat(filepath.Join(home, ".cf", "profiles", "missing"))
+		assert.True(t, os.IsNotExist(err))
+
+		config, err := NewConfigurationDiskRepository().Get()
+		assert.Equal(t, err, UnknownProfileError{Name: "missing"})
+		assert.Equal(t, config.Target, defaultConfig().Target)
+		assert.Equal(t, CheckCurrentProfile(), UnknownProfileError{Name: "missing"})
+	})
+}
+
+func TestCfProfileCannotEscapeTheConfigDirectory(t *testing.T) {
+	withTempCfHome(t, func(home string) {
+		os.Setenv("CF_PROFILE", "../../escape")
+
+		_, err := ConfigFile()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid profile name")
+
+		_, err = os.Stat(filepath.Join(home, "escape"))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestDeletingProfiles(t *testing.T) {
+	withTempCfHome(t, func(home string) {
+		repo := NewProfileDiskRepository()
+		assert.NoError(t, repo.Create("staging"))
+		assert.NoError(t, repo.Create("production"))
+		assert.NoError(t, repo.Use("production"))
+
+		assert.Error(t, repo.Delete("default"))
+		assert.Error(t, repo.Delete("production"))
+		assert.Error(t, repo.Delete("missing"))
+		assert.NoError(t, repo.Delete("staging"))
+
+		names, err := repo.List()
+		assert.NoError(t, err)
+		assert.Equal(t, names, []string{"default", "production"})
+	})
+}
@@ -16,6 +16,9 @@ const (
 
 var singleton *Configuration
 
+// The tokens as they were last read from or written to the token store
+var loadedTokens Tokens
+
 type ConfigurationRepository interface {
 	Get() (config *Configuration, err error)
 	Delete()
@@ -24,17 +27,23 @@ type ConfigurationRepository interface {
 }
 
 type ConfigurationDiskRepository struct {
+	tokenStore TokenStore
 }
 
 func NewConfigurationDiskRepository() (repo ConfigurationDiskRepository) {
 	return ConfigurationDiskRepository{}
 }
 
+// With an unknown profile the defaults are returned along with the error, so
+// that the commands which do not need a config can still run.
 func (repo ConfigurationDiskRepository) Get() (c *Configuration, err error) {
 	if singleton == nil {
-		singleton, err = load()
+		singleton, err = repo.load()
 
 		if err != nil {
+			if _, ok := err.(UnknownProfileError); ok {
+				c = singleton
+			}
 			return
 		}
 	}
@@ -50,6 +59,9 @@ func (repo ConfigurationDiskRepository) Delete() {
 	}
 
 	os.Remove(file)
+	if tokenStore, err := repo.getTokenStore(); err == nil {
+		tokenStore.Delete()
+	}
 	singleton = nil
 }
 
@@ -58,7 +70,7 @@ func (repo ConfigurationDiskRepository) Save() (err error) {
 	if err != nil {
 		return
 	}
-	return saveConfiguration(c)
+	return repo.saveConfiguration(c)
 }
 
 func (repo ConfigurationDiskRepository) ClearSession() (err error) {
@@ -67,27 +79,59 @@ func (repo ConfigurationDiskRepository) ClearSession() (err error) {
 		return
 	}
 	c.AccessToken = ""
+	c.ClientId = ""
+	c.ClientSecret = ""
 	c.Organization = cf.Organization{}
 	c.Space = cf.Space{}
 
-	return saveConfiguration(c)
+	return repo.saveConfiguration(c)
 }
 
 // Keep this one public for configtest/configuration.go
 func ConfigFile() (file string, err error) {
+	return configDirFile("config.json")
+}
 
-	configDir := filepath.Join(userHomeDir(), ".cf")
+func TokenFile() (file string, err error) {
+	return configDirFile("tokens.json")
+}
 
-	err = os.MkdirAll(configDir, dirPermissions)
+func EncryptedTokenFile() (file string, err error) {
+	return configDirFile("tokens.enc")
+}
 
+// Only the default profile is created on demand, other profiles have to be
+// created with 'cf profile create' first.
+func configDirFile(name string) (file string, err error) {
+	profile := CurrentProfile()
+	err = checkProfileExists(profile)
 	if err != nil {
 		return
 	}
 
-	file = filepath.Join(configDir, "config.json")
+	dir := profileDir(profile)
+	if profile == DefaultProfile {
+		err = os.MkdirAll(dir, dirPermissions)
+		if err != nil {
+			return
+		}
+	}
+
+	file = filepath.Join(dir, name)
 	return
 }
 
+func configDir() string {
+	return filepath.Join(cfHomeDir(), ".cf")
+}
+
+func cfHomeDir() string {
+	if home := os.Getenv("CF_HOME"); home != "" {
+		return home
+	}
+	return userHomeDir()
+}
+
 // See: http://stackoverflow.com/questions/7922270/obtain-users-home-directory
 // we can't cross compile using cgo and use user.Current()
 func userHomeDir() string {
@@ -104,6 +148,7 @@ func userHomeDir() string {
 
 func defaultConfig() (c *Configuration) {
 	c = new(Configuration)
+	c.ConfigVersion = CurrentConfigVersion
 	c.Target = "https://api.run.pivotal.io"
 	c.ApiVersion = "2"
 	c.AuthorizationEndpoint = "https://login.run.pivotal.io"
@@ -112,39 +157,108 @@ func defaultConfig() (c *Configuration) {
 	return
 }
 
-func load() (c *Configuration, parseError error) {
+func (repo ConfigurationDiskRepository) load() (c *Configuration, parseError error) {
+	var migrated bool
+	loadedTokens = Tokens{}
 	file, readError := ConfigFile()
 	c = new(Configuration)
 
 	if readError != nil {
 		c := defaultConfig()
-		return c, saveConfiguration(c)
+		return c, repo.saveConfiguration(c)
 	}
 
 	data, readError := ioutil.ReadFile(file)
 
 	if readError != nil {
 		c := defaultConfig()
-		return c, saveConfiguration(c)
+		return c, repo.saveConfiguration(c)
+	}
+
+	rawConfig := map[string]interface{}{}
+	if json.Unmarshal(data, &rawConfig) == nil {
+		migrated, parseError = migrateConfig(rawConfig)
+		if parseError != nil {
+			return
+		}
+		data, _ = json.Marshal(rawConfig)
+	}
+
+	// Keep a corrupted file around for inspection and start over with the defaults
+	if json.Unmarshal(data, c) != nil {
+		os.Rename(file, file+".corrupt")
+		c := defaultConfig()
+		return c, repo.saveConfiguration(c)
 	}
 
-	parseError = json.Unmarshal(data, c)
+	// Older versions kept the tokens in the config file itself
+	if c.tokens() != (Tokens{}) {
+		parseError = repo.saveConfiguration(c)
+		return
+	}
 
+	tokenStore, parseError := repo.getTokenStore()
+	if parseError != nil {
+		return
+	}
+
+	tokens, parseError := tokenStore.Load()
+	if parseError != nil {
+		return
+	}
+
+	c.setTokens(tokens)
+	loadedTokens = tokens
+
+	if migrated {
+		parseError = repo.saveConfiguration(c)
+	}
 	return
 }
 
-func saveConfiguration(config *Configuration) (err error) {
-	bytes, err := json.Marshal(config)
+func (repo ConfigurationDiskRepository) saveConfiguration(config *Configuration) (err error) {
+	tokenStore, err := repo.getTokenStore()
 	if err != nil {
 		return
 	}
 
 	file, err := ConfigFile()
-
 	if err != nil {
 		return
 	}
-	err = ioutil.WriteFile(file, bytes, filePermissions)
 
-	return
+	return withFileLock(file, func() (err error) {
+		// Another process may have refreshed the tokens since they were loaded.
+		// Unless they were changed here as well, keep the newer ones on disk.
+		if config.tokens() == loadedTokens {
+			storedTokens, loadErr := tokenStore.Load()
+			if loadErr == nil && storedTokens.AccessToken != "" {
+				config.setTokens(storedTokens)
+			}
+		}
+
+		err = tokenStore.Save(config.tokens())
+		if err != nil {
+			return
+		}
+		loadedTokens = config.tokens()
+
+		configWithoutTokens := *config
+		configWithoutTokens.setTokens(Tokens{})
+
+		bytes, err := json.Marshal(configWithoutTokens)
+		if err != nil {
+			return
+		}
+
+		return writeFileAtomically(file, bytes, filePermissions)
+	})
+}
+
+// The token store follows the current profile unless one was given explicitly
+func (repo ConfigurationDiskRepository) getTokenStore() (store TokenStore, err error) {
+	if repo.tokenStore != nil {
+		return repo.tokenStore, nil
+	}
+	return NewTokenStore()
 }
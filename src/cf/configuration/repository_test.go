@@ -1,8 +1,12 @@
 package configuration
 
 import (
+	"encoding/json"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -35,34 +39,175 @@ func TestSavingAndLoading(t *testing.T) {
 	assert.Equal(t, savedConfig, configToSave)
 }
 
-func (repo ConfigurationDiskRepository) loadDefaultConfig(t *testing.T) (config *Configuration) {
-	file, err := ConfigFile()
+func TestSavingKeepsTokensOutOfTheConfigFile(t *testing.T) {
+	repo := NewConfigurationDiskRepository()
+	config := repo.loadDefaultConfig(t)
+	defer repo.restoreConfig(t)
+
+	config.AccessToken = "bearer my_access_token"
+	config.RefreshToken = "my_refresh_token"
+	repo.Save()
+
+	configFile, err := ConfigFile()
+	assert.NoError(t, err)
+	data, err := ioutil.ReadFile(configFile)
 	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "my_access_token")
+	assert.NotContains(t, string(data), "my_refresh_token")
 
-	_, err = os.Stat(file)
-	if !os.IsNotExist(err) {
-		err = os.Rename(file, file+"test-backup")
-		assert.NoError(t, err)
-	}
+	tokenFile, err := TokenFile()
+	assert.NoError(t, err)
+	fileInfo, err := os.Stat(tokenFile)
+	assert.NoError(t, err)
+	assert.Equal(t, fileInfo.Mode().Perm(), os.FileMode(0600))
+}
 
-	config, err = repo.Get()
+func TestLoadingMigratesTokensFromOldConfigFiles(t *testing.T) {
+	repo := NewConfigurationDiskRepository()
+	repo.loadDefaultConfig(t)
+	defer repo.restoreConfig(t)
+
+	configFile, err := ConfigFile()
+	assert.NoError(t, err)
+	err = ioutil.WriteFile(configFile, []byte(`{"Target":"https://api.example.com","AccessToken":"bearer my_access_token","RefreshToken":"my_refresh_token"}`), 0644)
 	assert.NoError(t, err)
 
-	return
+	singleton = nil
+	config, err := repo.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, config.Target, "https://api.example.com")
+	assert.Equal(t, config.AccessToken, "bearer my_access_token")
+	assert.Equal(t, config.RefreshToken, "my_refresh_token")
+
+	data, err := ioutil.ReadFile(configFile)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "my_access_token")
+
+	tokenStore, err := repo.getTokenStore()
+	assert.NoError(t, err)
+	tokens, err := tokenStore.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, tokens.AccessToken, "bearer my_access_token")
+	assert.Equal(t, tokens.RefreshToken, "my_refresh_token")
 }
 
-func (repo ConfigurationDiskRepository) restoreConfig(t *testing.T) {
-	file, err := ConfigFile()
+func TestLoadingRecoversFromACorruptedConfigFile(t *testing.T) {
+	repo := NewConfigurationDiskRepository()
+	repo.loadDefaultConfig(t)
+	defer repo.restoreConfig(t)
+
+	configFile, err := ConfigFile()
+	assert.NoError(t, err)
+	err = ioutil.WriteFile(configFile, []byte(`{"Target":"https://api.exa`), 0644)
+	assert.NoError(t, err)
+
+	singleton = nil
+	config, err := repo.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, config.Target, "https://api.run.pivotal.io")
+
+	corruptedData, err := ioutil.ReadFile(configFile + ".corrupt")
+	assert.NoError(t, err)
+	assert.Equal(t, string(corruptedData), `{"Target":"https://api.exa`)
+}
+
+func TestSavingKeepsTokensRefreshedByAnotherProcess(t *testing.T) {
+	repo := NewConfigurationDiskRepository()
+	config := repo.loadDefaultConfig(t)
+	defer repo.restoreConfig(t)
+
+	config.AccessToken = "bearer old_access_token"
+	config.RefreshToken = "my_refresh_token"
+	assert.NoError(t, repo.Save())
+
+	tokenStore, err := repo.getTokenStore()
+	assert.NoError(t, err)
+	err = tokenStore.Save(Tokens{AccessToken: "bearer new_access_token", RefreshToken: "my_refresh_token"})
 	assert.NoError(t, err)
 
-	err = os.Remove(file)
+	config.Target = "https://api.example.com"
+	assert.NoError(t, repo.Save())
+
+	singleton = nil
+	savedConfig, err := repo.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, savedConfig.Target, "https://api.example.com")
+	assert.Equal(t, savedConfig.AccessToken, "bearer new_access_token")
+}
+
+func TestSavingOverwritesTokensChangedInThisProcess(t *testing.T) {
+	repo := NewConfigurationDiskRepository()
+	config := repo.loadDefaultConfig(t)
+	defer repo.restoreConfig(t)
+
+	config.AccessToken = "bearer old_access_token"
+	assert.NoError(t, repo.Save())
+
+	tokenStore, err := repo.getTokenStore()
+	assert.NoError(t, err)
+	err = tokenStore.Save(Tokens{AccessToken: "bearer other_access_token"})
 	assert.NoError(t, err)
 
-	_, err = os.Stat(file + "test-backup")
-	if !os.IsNotExist(err) {
-		err = os.Rename(file+"test-backup", file)
-		assert.NoError(t, err)
+	config.AccessToken = "bearer my_access_token"
+	assert.NoError(t, repo.Save())
+
+	tokens, err := tokenStore.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, tokens.AccessToken, "bearer my_access_token")
+}
+
+func TestConcurrentSavesNeverLeaveAPartialFile(t *testing.T) {
+	repo := NewConfigurationDiskRepository()
+	config := repo.loadDefaultConfig(t)
+	defer repo.restoreConfig(t)
+
+	config.Target = "https://api.example.com/" + strings.Repeat("x", 10000)
+
+	done := make(chan error)
+	for i := 0; i < 10; i++ {
+		go func() {
+			done <- repo.Save()
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, <-done)
 	}
 
+	configFile, err := ConfigFile()
+	assert.NoError(t, err)
+	data, err := ioutil.ReadFile(configFile)
+	assert.NoError(t, err)
+
+	savedConfig := new(Configuration)
+	assert.NoError(t, json.Unmarshal(data, savedConfig))
+	assert.Equal(t, savedConfig.Target, config.Target)
+
+	files, err := filepath.Glob(configFile + ".tmp*")
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+var originalHome string
+
+func (repo ConfigurationDiskRepository) loadDefaultConfig(t *testing.T) (config *Configuration) {
+	tempHome, err := ioutil.TempDir("", "cf-home")
+	assert.NoError(t, err)
+
+	originalHome = os.Getenv("HOME")
+	os.Setenv("HOME", tempHome)
+
+	singleton = nil
+	config, err = repo.Get()
+	assert.NoError(t, err)
+
+	return
+}
+
+func (repo ConfigurationDiskRepository) restoreConfig(t *testing.T) {
+	err := os.RemoveAll(os.Getenv("HOME"))
+	assert.NoError(t, err)
+
+	os.Setenv("HOME", originalHome)
+	singleton = nil
 	return
 }
@@ -1,14 +1,65 @@
 package configuration
 
 import (
+	"cf/i18n"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"strings"
+	"time"
 )
 
+// The scope granted to admins, which admin commands need
+const AdminScope = "cloud_controller.admin"
+
+type TokenInfo struct {
+	Username  string   `json:"user_name"`
+	Email     string   `json:"email"`
+	UserGuid  string   `json:"user_id"`
+	ClientId  string   `json:"client_id"`
+	Issuer    string   `json:"iss"`
+	Scopes    []string `json:"scope"`
+	Expiry    int64    `json:"exp"`
+	GrantType string   `json:"grant_type"`
+}
+
+func NewTokenInfo(accessToken string) (info TokenInfo, err error) {
+	clearInfo, err := DecodeTokenInfo(accessToken)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(clearInfo, &info)
+	return
+}
+
+func (info TokenInfo) ExpiresAt() time.Time {
+	return time.Unix(info.Expiry, 0)
+}
+
+func (info TokenInfo) HasScope(scope string) bool {
+	for _, grantedScope := range info.Scopes {
+		if grantedScope == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (info TokenInfo) MissingScopes(scopes ...string) (missing []string) {
+	for _, scope := range scopes {
+		if !info.HasScope(scope) {
+			missing = append(missing, scope)
+		}
+	}
+	return
+}
+
 func DecodeTokenInfo(accessToken string) (clearTokenInfo []byte, err error) {
 	tokenParts := strings.Split(accessToken, " ")
 
 	if len(tokenParts) < 2 {
+		err = errors.New(i18n.T("token.missing_type"))
 		return
 	}
 
@@ -16,6 +67,7 @@ func DecodeTokenInfo(accessToken string) (clearTokenInfo []byte, err error) {
 	encodedInfoParts := strings.Split(token, ".")
 
 	if len(encodedInfoParts) < 3 {
+		err = errors.New(i18n.T("token.not_a_jwt"))
 		return
 	}
 
@@ -23,8 +75,24 @@ func DecodeTokenInfo(accessToken string) (clearTokenInfo []byte, err error) {
 	return base64Decode(encodedInfo)
 }
 
+func TokenExpiresAt(accessToken string) (expiresAt time.Time, err error) {
+	info, err := NewTokenInfo(accessToken)
+	if err != nil {
+		return
+	}
+
+	if info.Expiry == 0 {
+		err = errors.New(i18n.T("token.no_expiry"))
+		return
+	}
+
+	expiresAt = info.ExpiresAt()
+	return
+}
+
+// JWT segments are base64url encoded without padding
 func base64Decode(encodedInfo string) ([]byte, error) {
-	return base64.StdEncoding.DecodeString(restorePadding(encodedInfo))
+	return base64.URLEncoding.DecodeString(restorePadding(encodedInfo))
 }
 
 func restorePadding(seg string) string {
@@ -32,7 +100,7 @@ func restorePadding(seg string) string {
 	case 2:
 		seg = seg + "=="
 	case 3:
-		seg = seg + "==="
+		seg = seg + "="
 	}
 	return seg
 }
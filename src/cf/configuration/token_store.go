@@ -0,0 +1,253 @@
+package configuration
+
+import (
+	"cf/i18n"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+)
+
+const (
+	tokenFilePermissions    = 0600
+	keyDerivationIterations = 600000
+	keyLength               = 32
+	saltLength              = 16
+)
+
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+	ClientSecret string
+}
+
+type TokenStore interface {
+	Load() (tokens Tokens, err error)
+	Save(tokens Tokens) (err error)
+	Delete()
+}
+
+// Setting CF_TOKEN_PASSPHRASE moves any tokens stored in plain text into the
+// encrypted store, so they do not stay readable on disk.
+func NewTokenStore() (store TokenStore, err error) {
+	file, err := TokenFile()
+	if err != nil {
+		return
+	}
+	fileStore := NewFileTokenStore(file)
+
+	passphrase := os.Getenv("CF_TOKEN_PASSPHRASE")
+	if passphrase == "" {
+		return fileStore, nil
+	}
+
+	encryptedFile, err := EncryptedTokenFile()
+	if err != nil {
+		return
+	}
+	encryptedStore := NewEncryptedTokenStore(encryptedFile, passphrase)
+
+	err = moveTokens(fileStore, encryptedStore)
+	return encryptedStore, err
+}
+
+// The plain text file is only written while no passphrase is set, so when it
+// exists it holds the most recent tokens.
+func moveTokens(from FileTokenStore, to TokenStore) (err error) {
+	if _, err = os.Stat(from.path); os.IsNotExist(err) {
+		return nil
+	}
+
+	tokens, err := from.Load()
+	if err != nil {
+		return
+	}
+
+	err = to.Save(tokens)
+	if err != nil {
+		return
+	}
+
+	from.Delete()
+	return
+}
+
+type FileTokenStore struct {
+	path string
+}
+
+func NewFileTokenStore(path string) (store FileTokenStore) {
+	store.path = path
+	return
+}
+
+func (store FileTokenStore) Load() (tokens Tokens, err error) {
+	data, err := ioutil.ReadFile(store.path)
+	if os.IsNotExist(err) {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(data, &tokens)
+	return
+}
+
+func (store FileTokenStore) Save(tokens Tokens) (err error) {
+	data, err := json.Marshal(tokens)
+	if err != nil {
+		return
+	}
+	return writeTokenFile(store.path, data)
+}
+
+func (store FileTokenStore) Delete() {
+	os.Remove(store.path)
+}
+
+type EncryptedTokenStore struct {
+	path       string
+	passphrase string
+}
+
+func NewEncryptedTokenStore(path, passphrase string) (store EncryptedTokenStore) {
+	store.path = path
+	store.passphrase = passphrase
+	return
+}
+
+type encryptedTokens struct {
+	Salt       []byte
+	IV         []byte
+	Ciphertext []byte
+	Mac        []byte
+}
+
+func (store EncryptedTokenStore) Load() (tokens Tokens, err error) {
+	data, err := ioutil.ReadFile(store.path)
+	if os.IsNotExist(err) {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	encrypted := encryptedTokens{}
+	err = json.Unmarshal(data, &encrypted)
+	if err != nil {
+		return
+	}
+
+	if len(encrypted.IV) != aes.BlockSize {
+		err = errors.New(i18n.T("token_store.corrupt_file"))
+		return
+	}
+
+	encryptionKey, macKey := deriveKeys(store.passphrase, encrypted.Salt)
+	if !hmac.Equal(tokenMac(macKey, encrypted.IV, encrypted.Ciphertext), encrypted.Mac) {
+		err = errors.New(i18n.T("token_store.wrong_passphrase"))
+		return
+	}
+
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return
+	}
+
+	plaintext := make([]byte, len(encrypted.Ciphertext))
+	cipher.NewCTR(block, encrypted.IV).XORKeyStream(plaintext, encrypted.Ciphertext)
+
+	err = json.Unmarshal(plaintext, &tokens)
+	return
+}
+
+func (store EncryptedTokenStore) Save(tokens Tokens) (err error) {
+	plaintext, err := json.Marshal(tokens)
+	if err != nil {
+		return
+	}
+
+	encrypted := encryptedTokens{
+		Salt: make([]byte, saltLength),
+		IV:   make([]byte, aes.BlockSize),
+	}
+	_, err = rand.Read(encrypted.Salt)
+	if err != nil {
+		return
+	}
+	_, err = rand.Read(encrypted.IV)
+	if err != nil {
+		return
+	}
+
+	encryptionKey, macKey := deriveKeys(store.passphrase, encrypted.Salt)
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return
+	}
+
+	encrypted.Ciphertext = make([]byte, len(plaintext))
+	cipher.NewCTR(block, encrypted.IV).XORKeyStream(encrypted.Ciphertext, plaintext)
+	encrypted.Mac = tokenMac(macKey, encrypted.IV, encrypted.Ciphertext)
+
+	data, err := json.Marshal(encrypted)
+	if err != nil {
+		return
+	}
+	return writeTokenFile(store.path, data)
+}
+
+func (store EncryptedTokenStore) Delete() {
+	os.Remove(store.path)
+}
+
+// Tokens are encrypted with AES-256 in CTR mode and authenticated with
+// HMAC-SHA256, using separate keys derived from the passphrase with
+// PBKDF2-HMAC-SHA256.
+func deriveKeys(passphrase string, salt []byte) (encryptionKey, macKey []byte) {
+	key := pbkdf2Key([]byte(passphrase), salt, keyDerivationIterations, 2*keyLength)
+	return key[:keyLength], key[keyLength:]
+}
+
+// PBKDF2 as in RFC 2898 with HMAC-SHA256 as the pseudorandom function
+func pbkdf2Key(password, salt []byte, iterations, length int) (key []byte) {
+	prf := hmac.New(sha256.New, password)
+	for block := uint32(1); len(key) < length; block++ {
+		prf.Reset()
+		prf.Write(salt)
+		prf.Write([]byte{byte(block >> 24), byte(block >> 16), byte(block >> 8), byte(block)})
+		u := prf.Sum(nil)
+
+		t := make([]byte, len(u))
+		copy(t, u)
+		for i := 1; i < iterations; i++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		key = append(key, t...)
+	}
+	return key[:length]
+}
+
+func tokenMac(key, iv, ciphertext []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(iv)
+	mac.Write(ciphertext)
+	return mac.Sum(nil)
+}
+
+func writeTokenFile(path string, data []byte) (err error) {
+	return writeFileAtomically(path, data, tokenFilePermissions)
+}
@@ -0,0 +1,138 @@
+package configuration
+
+import (
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var testTokens = Tokens{
+	AccessToken:  "bearer my_access_token",
+	RefreshToken: "my_refresh_token",
+	ClientSecret: "my_client_secret",
+}
+
+func withTempDir(t *testing.T, f func(dir string)) {
+	dir, err := ioutil.TempDir("", "token-store")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	f(dir)
+}
+
+func TestFileTokenStoreSavesAndLoadsTokens(t *testing.T) {
+	withTempDir(t, func(dir string) {
+		path := filepath.Join(dir, "tokens.json")
+		store := NewFileTokenStore(path)
+
+		err := store.Save(testTokens)
+		assert.NoError(t, err)
+
+		fileInfo, err := os.Stat(path)
+		assert.NoError(t, err)
+		assert.Equal(t, fileInfo.Mode().Perm(), os.FileMode(0600))
+
+		tokens, err := store.Load()
+		assert.NoError(t, err)
+		assert.Equal(t, tokens, testTokens)
+	})
+}
+
+func TestFileTokenStoreRestrictsPermissionsOfExistingFiles(t *testing.T) {
+	withTempDir(t, func(dir string) {
+		path := filepath.Join(dir, "tokens.json")
+		err := ioutil.WriteFile(path, []byte("{}"), 0644)
+		assert.NoError(t, err)
+
+		err = NewFileTokenStore(path).Save(testTokens)
+		assert.NoError(t, err)
+
+		fileInfo, err := os.Stat(path)
+		assert.NoError(t, err)
+		assert.Equal(t, fileInfo.Mode().Perm(), os.FileMode(0600))
+	})
+}
+
+func TestFileTokenStoreLoadsNothingWhenThereIsNoFile(t *testing.T) {
+	withTempDir(t, func(dir string) {
+		tokens, err := NewFileTokenStore(filepath.Join(dir, "tokens.json")).Load()
+
+		assert.NoError(t, err)
+		assert.Equal(t, tokens, Tokens{})
+	})
+}
+
+func TestEncryptedTokenStoreSavesAndLoadsTokens(t *testing.T) {
+	withTempDir(t, func(dir string) {
+		path := filepath.Join(dir, "tokens.enc")
+		store := NewEncryptedTokenStore(path, "my passphrase")
+
+		err := store.Save(testTokens)
+		assert.NoError(t, err)
+
+		data, err := ioutil.ReadFile(path)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(data), "my_access_token")
+		assert.NotContains(t, string(data), "my_refresh_token")
+		assert.NotContains(t, string(data), "my_client_secret")
+
+		tokens, err := store.Load()
+		assert.NoError(t, err)
+		assert.Equal(t, tokens, testTokens)
+	})
+}
+
+func TestEncryptedTokenStoreWithTheWrongPassphrase(t *testing.T) {
+	withTempDir(t, func(dir string) {
+		path := filepath.Join(dir, "tokens.enc")
+		err := NewEncryptedTokenStore(path, "my passphrase").Save(testTokens)
+		assert.NoError(t, err)
+
+		tokens, err := NewEncryptedTokenStore(path, "wrong passphrase").Load()
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "CF_TOKEN_PASSPHRASE")
+		assert.Equal(t, tokens, Tokens{})
+	})
+}
+
+// The PBKDF2-HMAC-SHA256 test vectors of RFC 7914
+func TestPbkdf2KeyMatchesTheReferenceVectors(t *testing.T) {
+	key := pbkdf2Key([]byte("passwd"), []byte("salt"), 1, 64)
+	assert.Equal(t, hex.EncodeToString(key), "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783")
+
+	key = pbkdf2Key([]byte("Password"), []byte("NaCl"), 80000, 64)
+	assert.Equal(t, hex.EncodeToString(key), "4ddcd8f60b98be21830cee5ef22701f9641a4418d04c0414aeff08876b34ab56a1d425a1225833549adb841b51c9b3176a272bdebba1d078478f62b397f33c8d")
+}
+
+func TestSettingAPassphraseMovesPlainTextTokensIntoTheEncryptedStore(t *testing.T) {
+	withTempCfHome(t, func(home string) {
+		oldPassphrase := os.Getenv("CF_TOKEN_PASSPHRASE")
+		os.Setenv("CF_TOKEN_PASSPHRASE", "")
+		defer os.Setenv("CF_TOKEN_PASSPHRASE", oldPassphrase)
+
+		store, err := NewTokenStore()
+		assert.NoError(t, err)
+		assert.NoError(t, store.Save(testTokens))
+
+		plaintextFile, err := TokenFile()
+		assert.NoError(t, err)
+		_, err = os.Stat(plaintextFile)
+		assert.NoError(t, err)
+
+		os.Setenv("CF_TOKEN_PASSPHRASE", "my passphrase")
+
+		store, err = NewTokenStore()
+		assert.NoError(t, err)
+
+		_, err = os.Stat(plaintextFile)
+		assert.True(t, os.IsNotExist(err))
+
+		tokens, err := store.Load()
+		assert.NoError(t, err)
+		assert.Equal(t, tokens, testTokens)
+	})
+}
@@ -5,6 +5,22 @@ import (
 	"time"
 )
 
+type AuthPromptType string
+
+const (
+	AuthPromptTypeText     AuthPromptType = "TEXT"
+	AuthPromptTypePassword AuthPromptType = "PASSWORD"
+)
+
+type AuthPrompt struct {
+	Type        AuthPromptType
+	DisplayName string
+}
+
+// The json tags below define the documented output of read commands run with
+// '--output json' or '--output yaml'. Scripts depend on these names, so fields
+// may be added but existing names must not change.
+
 type InstanceState string
 
 const (
@@ -15,19 +31,19 @@ const (
 )
 
 type Organization struct {
-	Name    string
-	Guid    string
-	Spaces  []Space
-	Domains []Domain
+	Name    string   `json:"name"`
+	Guid    string   `json:"guid"`
+	Spaces  []Space  `json:"spaces,omitempty"`
+	Domains []Domain `json:"domains,omitempty"`
 }
 
 type Space struct {
-	Name             string
-	Guid             string
-	Applications     []Application
-	ServiceInstances []ServiceInstance
-	Organization     Organization
-	Domains          []Domain
+	Name             string            `json:"name"`
+	Guid             string            `json:"guid"`
+	Applications     []Application     `json:"applications,omitempty"`
+	ServiceInstances []ServiceInstance `json:"service_instances,omitempty"`
+	Organization     Organization      `json:"organization"`
+	Domains          []Domain          `json:"domains,omitempty"`
 }
 
 func (space Space) String() string {
@@ -35,23 +51,23 @@ func (space Space) String() string {
 }
 
 type Application struct {
-	Name             string
-	Guid             string
-	State            string
-	Instances        int
-	RunningInstances int
-	Memory           uint64 // in Megabytes
-	DiskQuota        uint64 // in Megabytes
-	Urls             []string
-	BuildpackUrl     string
-	Stack            Stack
-	EnvironmentVars  map[string]string
-	Command          string
+	Name             string            `json:"name"`
+	Guid             string            `json:"guid"`
+	State            string            `json:"state"`
+	Instances        int               `json:"instances"`
+	RunningInstances int               `json:"running_instances"`
+	Memory           uint64            `json:"memory"`     // in Megabytes
+	DiskQuota        uint64            `json:"disk_quota"` // in Megabytes
+	Urls             []string          `json:"urls"`
+	BuildpackUrl     string            `json:"buildpack_url"`
+	Stack            Stack             `json:"stack"`
+	EnvironmentVars  map[string]string `json:"environment_vars,omitempty"`
+	Command          string            `json:"command"`
 }
 
 type AppSummary struct {
-	App       Application
-	Instances []ApplicationInstance
+	App       Application           `json:"app"`
+	Instances []ApplicationInstance `json:"instances"`
 }
 
 type AppFile struct {
@@ -61,24 +77,24 @@ type AppFile struct {
 }
 
 type Domain struct {
-	Name   string
-	Guid   string
-	Shared bool
-	Spaces []Space
+	Name   string  `json:"name"`
+	Guid   string  `json:"guid"`
+	Shared bool    `json:"shared"`
+	Spaces []Space `json:"spaces"`
 }
 
 type Event struct {
-	InstanceIndex   int
-	Timestamp       time.Time
-	ExitDescription string
-	ExitStatus      int
+	InstanceIndex   int       `json:"instance_index"`
+	Timestamp       time.Time `json:"timestamp"`
+	ExitDescription string    `json:"exit_description"`
+	ExitStatus      int       `json:"exit_status"`
 }
 
 type Route struct {
-	Host     string
-	Guid     string
-	Domain   Domain
-	AppNames []string
+	Host     string   `json:"host"`
+	Guid     string   `json:"guid"`
+	Domain   Domain   `json:"domain"`
+	AppNames []string `json:"app_names"`
 }
 
 func (r Route) URL() string {
@@ -89,43 +105,43 @@ func (r Route) URL() string {
 }
 
 type Stack struct {
-	Name        string
-	Guid        string
-	Description string
+	Name        string `json:"name"`
+	Guid        string `json:"guid"`
+	Description string `json:"description"`
 }
 
 type ApplicationInstance struct {
-	State     InstanceState
-	Since     time.Time
-	CpuUsage  float64 // percentage
-	DiskQuota uint64  // in bytes
-	DiskUsage uint64
-	MemQuota  uint64
-	MemUsage  uint64
+	State     InstanceState `json:"state"`
+	Since     time.Time     `json:"since"`
+	CpuUsage  float64       `json:"cpu_usage"`  // percentage
+	DiskQuota uint64        `json:"disk_quota"` // in bytes
+	DiskUsage uint64        `json:"disk_usage"`
+	MemQuota  uint64        `json:"mem_quota"`
+	MemUsage  uint64        `json:"mem_usage"`
 }
 
 type ServicePlan struct {
-	Name            string
-	Guid            string
-	ServiceOffering ServiceOffering
+	Name            string          `json:"name"`
+	Guid            string          `json:"guid"`
+	ServiceOffering ServiceOffering `json:"service_offering"`
 }
 
 type ServiceOffering struct {
-	Guid             string
-	Label            string
-	Provider         string
-	Version          string
-	Description      string
-	DocumentationUrl string
-	Plans            []ServicePlan
+	Guid             string        `json:"guid"`
+	Label            string        `json:"label"`
+	Provider         string        `json:"provider"`
+	Version          string        `json:"version"`
+	Description      string        `json:"description"`
+	DocumentationUrl string        `json:"documentation_url"`
+	Plans            []ServicePlan `json:"plans,omitempty"`
 }
 
 type ServiceInstance struct {
-	Name             string
-	Guid             string
-	ServiceBindings  []ServiceBinding
-	ServicePlan      ServicePlan
-	ApplicationNames []string
+	Name             string           `json:"name"`
+	Guid             string           `json:"guid"`
+	ServiceBindings  []ServiceBinding `json:"service_bindings"`
+	ServicePlan      ServicePlan      `json:"service_plan"`
+	ApplicationNames []string         `json:"application_names"`
 }
 
 func (inst ServiceInstance) IsUserProvided() bool {
@@ -137,9 +153,9 @@ func (inst ServiceInstance) ServiceOffering() ServiceOffering {
 }
 
 type ServiceBinding struct {
-	Url     string
-	Guid    string
-	AppGuid string
+	Url     string `json:"url"`
+	Guid    string `json:"guid"`
+	AppGuid string `json:"app_guid"`
 }
 
 type Quota struct {
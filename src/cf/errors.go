@@ -0,0 +1,36 @@
+package cf
+
+import "fmt"
+
+type ErrorKind int
+
+const (
+	GeneralError ErrorKind = iota
+	UsageError
+	AuthError
+	SessionExpiredError
+	NotFoundError
+	ServerError
+)
+
+// Commands report failures to the user and return a CommandError, whose kind
+// decides the exit code.
+type CommandError struct {
+	Kind    ErrorKind
+	Message string
+}
+
+func NewCommandError(kind ErrorKind, message string, args ...interface{}) CommandError {
+	return CommandError{Kind: kind, Message: fmt.Sprintf(message, args...)}
+}
+
+func (err CommandError) Error() string {
+	return err.Message
+}
+
+func ErrorKindOf(err error) ErrorKind {
+	if commandErr, ok := err.(CommandError); ok {
+		return commandErr.Kind
+	}
+	return GeneralError
+}
@@ -0,0 +1,277 @@
+package i18n
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+// The code whose messages are shown to users
+var translatedSources = []string{"../../main", "../app", "../commands", "../configuration", "../requirements", "../terminal/ui.go", "../suggestions.go"}
+
+// The code whose only messages shown to users are the errors it returns
+var errorSources = []string{"../api", "../net/gateway.go"}
+
+// The methods of terminal.UI that show a message, and the calls that build one
+var messageMethods = map[string]bool{
+	"Say":            true,
+	"Status":         true,
+	"Warn":           true,
+	"Failed":         true,
+	"Ask":            true,
+	"AskForPassword": true,
+	"Confirm":        true,
+	"Sprintf":        true,
+	"Errorf":         true,
+}
+
+// The calls that build an error, New being errors.New
+var errorMethods = map[string]bool{
+	"New":                       true,
+	"NewApiResponseWithMessage": true,
+	"NewApiResponseWithError":   true,
+}
+
+// The fields of cli apps, commands and flags shown in help
+var helpFields = map[string]bool{
+	"Description": true,
+	"Usage":       true,
+}
+
+// Formats that are not prose, so have nothing to translate
+var untranslatedFormats = map[string]bool{
+	"__fish_seen_subcommand_from %s; and test (__cf_arg_position) -eq %d": true,
+	"%s [Executor] ": true,
+	"%s api":         true,
+	"%s login":       true,
+	"%s target":      true,
+}
+
+var formatVerb = regexp.MustCompile(`%(\[\d+\])?[-+# 0]*\d*(\.\d+)?[a-zA-Z%]`)
+
+func TestCommandsOnlyShowTranslatedMessages(t *testing.T) {
+	inspectSources(t, func(position token.Position, call *ast.CallExpr, method string) {
+		if method == "T" {
+			return
+		}
+
+		format := call.Args[0]
+		if method == "NewCommandError" {
+			format = call.Args[1]
+		}
+
+		literal, ok := format.(*ast.BasicLit)
+		if !ok || literal.Kind != token.STRING {
+			return
+		}
+
+		text, _ := strconv.Unquote(literal.Value)
+		if isProse(text) && !untranslatedFormats[text] {
+			t.Errorf("%s: %s is not translated, add it to the catalogues and use i18n.T", position, literal.Value)
+		}
+	})
+}
+
+// Anything in the help of a command that is not looked up with i18n.T is only
+// allowed when it has no words, such as the name of the app.
+func TestHelpIsTranslated(t *testing.T) {
+	parseSources(t, translatedSources, func(fileSet *token.FileSet, file *ast.File) {
+		ast.Inspect(file, func(node ast.Node) bool {
+			var name string
+			var value ast.Expr
+			switch node := node.(type) {
+			case *ast.KeyValueExpr:
+				if key, ok := node.Key.(*ast.Ident); ok && helpFields[key.Name] {
+					name, value = key.Name, node.Value
+				}
+			case *ast.AssignStmt:
+				// The help templates of the cli package
+				if selector, ok := node.Lhs[0].(*ast.SelectorExpr); ok && strings.HasSuffix(selector.Sel.Name, "HelpTemplate") {
+					name, value = selector.Sel.Name, node.Rhs[0]
+				}
+			}
+			if value == nil {
+				return true
+			}
+
+			ast.Inspect(value, func(node ast.Node) bool {
+				if call, ok := node.(*ast.CallExpr); ok {
+					if selector, ok := call.Fun.(*ast.SelectorExpr); ok && selector.Sel.Name == "T" {
+						return false
+					}
+				}
+
+				literal, ok := node.(*ast.BasicLit)
+				if !ok || literal.Kind != token.STRING {
+					return true
+				}
+
+				text, _ := strconv.Unquote(literal.Value)
+				if isProse(text) {
+					t.Errorf("%s: %s %s is not translated, add it to the catalogues and use i18n.T", fileSet.Position(literal.Pos()), name, literal.Value)
+				}
+				return true
+			})
+			return false
+		})
+	})
+}
+
+func TestMessagesUsedAreInTheEnglishCatalogue(t *testing.T) {
+	inspectSources(t, func(position token.Position, call *ast.CallExpr, method string) {
+		if method != "T" {
+			return
+		}
+
+		literal, ok := call.Args[0].(*ast.BasicLit)
+		if !ok {
+			return
+		}
+
+		id, _ := strconv.Unquote(literal.Value)
+		if _, found := english[id]; !found {
+			t.Errorf("%s: message %s is not in the English catalogue", position, id)
+		}
+	})
+}
+
+// Catalogues may be partial, falling back to English. Run with -v to see the
+// messages each language still lacks.
+func TestListUntranslatedMessages(t *testing.T) {
+	for lang, catalogue := range catalogues {
+		if lang == DefaultLanguage {
+			continue
+		}
+
+		missing := []string{}
+		for id := range english {
+			if _, found := catalogue[id]; !found {
+				missing = append(missing, id)
+			}
+		}
+		sort.Strings(missing)
+
+		t.Logf("%s: %d of %d messages are not translated\n%s", lang, len(missing), len(english), strings.Join(missing, "\n"))
+	}
+}
+
+func TestTranslationsKeepTheArgumentsOfTheEnglishMessages(t *testing.T) {
+	for lang, catalogue := range catalogues {
+		for id, message := range catalogue {
+			englishMessage, found := english[id]
+			if !found {
+				t.Errorf("%s: message %s is not in the English catalogue", lang, id)
+				continue
+			}
+
+			if strings.Join(formatVerbs(message), " ") != strings.Join(formatVerbs(englishMessage), " ") {
+				t.Errorf("%s: message %s has the arguments %v, but in English %v", lang, id, formatVerbs(message), formatVerbs(englishMessage))
+			}
+		}
+	}
+}
+
+// Explicit argument indexes, as in %[1]s, need a newer fmt than the Go
+// version the CLI is built with.
+func TestMessagesHaveNoExplicitArgumentIndexes(t *testing.T) {
+	for lang, catalogue := range catalogues {
+		for id, message := range catalogue {
+			for _, verb := range formatVerbs(message) {
+				if strings.HasPrefix(verb, "%[") {
+					t.Errorf("%s: message %s uses the argument index %s", lang, id, verb)
+				}
+			}
+		}
+	}
+}
+
+func inspectSources(t *testing.T, inspect func(position token.Position, call *ast.CallExpr, method string)) {
+	inspectCalls := func(fileSet *token.FileSet, file *ast.File, messageMethods map[string]bool) {
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+
+			var method string
+			switch fun := call.Fun.(type) {
+			case *ast.SelectorExpr:
+				method = fun.Sel.Name
+				if pkg, ok := fun.X.(*ast.Ident); method == "New" && (!ok || pkg.Name != "errors") {
+					return true
+				}
+			case *ast.Ident:
+				// Calls within package net
+				method = fun.Name
+				if method == "New" {
+					return true
+				}
+			default:
+				return true
+			}
+
+			switch {
+			case messageMethods[method], errorMethods[method], method == "T":
+			case method == "NewCommandError" && len(call.Args) > 1:
+			default:
+				return true
+			}
+
+			inspect(fileSet.Position(call.Pos()), call, method)
+			return true
+		})
+	}
+
+	parseSources(t, translatedSources, func(fileSet *token.FileSet, file *ast.File) {
+		inspectCalls(fileSet, file, messageMethods)
+	})
+	parseSources(t, errorSources, func(fileSet *token.FileSet, file *ast.File) {
+		inspectCalls(fileSet, file, nil)
+	})
+}
+
+func parseSources(t *testing.T, sources []string, inspect func(fileSet *token.FileSet, file *ast.File)) {
+	fileSet := token.NewFileSet()
+
+	for _, source := range sources {
+		filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+			if err != nil || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+				return err
+			}
+
+			file, err := parser.ParseFile(fileSet, path, nil, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			inspect(fileSet, file)
+			return nil
+		})
+	}
+}
+
+func isProse(text string) bool {
+	for _, r := range formatVerb.ReplaceAllString(text, "") {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatVerbs(message string) (verbs []string) {
+	for _, verb := range formatVerb.FindAllString(message, -1) {
+		if verb != "%%" {
+			verbs = append(verbs, verb)
+		}
+	}
+	return
+}
@@ -0,0 +1,569 @@
+package i18n
+
+// Every message, grouped by the file that shows it. Other catalogues can leave
+// messages out.
+var english = map[string]string{
+	"api.api_endpoint":          "API endpoint: %s (API version: %s)",
+	"api.setting_api_endpoint":  "Setting api endpoint to %s...",
+	"api.warning_insecure_http": "\nWarning: Insecure http API endpoint detected: secure https API endpoints are recommended\n",
+
+	"app.api_description":                          "Set or view target api url",
+	"app.api_usage":                                "%s api [URL]",
+	"app.app_description":                          "Display health and status for app",
+	"app.app_usage":                                "%s app APP",
+	"app.apps_description":                         "List all apps in the target space",
+	"app.apps_usage":                               "%s apps",
+	"app.auth_client_credentials_flag":             "Use the client credentials grant, for UAA clients acting as service accounts",
+	"app.auth_description":                         "Authenticate user or client non-interactively",
+	"app.auth_examples":                            "EXAMPLE:\n   %s auth name@example.com pa55woRD (authenticate as a user)\n   %s auth --client-credentials my-client my-secret (authenticate as a UAA client)",
+	"app.auth_usage":                               "%s auth USERNAME PASSWORD\n   %s auth --client-credentials CLIENT_ID CLIENT_SECRET\n\n",
+	"app.bind_service_description":                 "Bind a service instance to an app",
+	"app.bind_service_usage":                       "%s bind-service APP SERVICE_INSTANCE",
+	"app.columns_flag":                             "Comma-separated list of table columns to show",
+	"app.completion_description":                   "Print a shell completion script for bash, zsh or fish",
+	"app.completion_usage":                         "%s completion bash|zsh|fish\n\nEXAMPLE:\n   source <(%s completion bash) (enable completion in the current bash shell)\n   %s completion fish | source (enable completion in the current fish shell)",
+	"app.config_description":                       "Manage command aliases, default flag values and the language of messages",
+	"app.config_usage":                             "%s config alias list\n   %s config alias set ALIAS \"COMMAND [ARGS...]\"\n   %s config alias unset ALIAS\n   %s config default list\n   %s config default set COMMAND FLAG VALUE\n   %s config default unset COMMAND FLAG\n   %s config language list\n   %s config language set LANGUAGE\n   %s config language unset\n\nEXAMPLE:\n   %s config alias set deploy \"push --no-route\" (cf deploy runs cf push --no-route)\n   %s config default set push m 512M (cf push uses 512M of memory unless -m is given)",
+	"app.create_org_description":                   "Create an org",
+	"app.create_org_usage":                         "%s create-org ORG",
+	"app.create_service_auth_token_description":    "Create a service auth token",
+	"app.create_service_auth_token_usage":          "%s create-service-auth-token LABEL PROVIDER TOKEN",
+	"app.create_service_broker_description":        "Create a service broker",
+	"app.create_service_broker_usage":              "%s create-service-broker SERVICE_BROKER USERNAME PASSWORD URL",
+	"app.create_service_description":               "Create a service instance",
+	"app.create_service_usage":                     "%s create-service SERVICE PLAN SERVICE_INSTANCE\n\nEXAMPLE:\n   %s create-service cleardb spark clear-db-mine\n\nTIP:\n   Use 'cf create-user-provided-service' to make user-provided services available to cf apps",
+	"app.create_space_description":                 "Create a space",
+	"app.create_space_usage":                       "%s create-space SPACE",
+	"app.create_user_description":                  "Create a new user",
+	"app.create_user_provided_service_description": "Make a user-provided service available to cf apps",
+	"app.create_user_provided_service_usage":       "%s create-user-provided-service SERVICE_INSTANCE \"comma, separated, parameter, names\"\n   %s create-user-provided-service SERVICE_INSTANCE '{\"name\":\"value\",\"name\":\"value\"}'\n\nEXAMPLE:\n   %s create-user-provided-service oracle-db-mine \"host, port, dbname, username, password\"\n   %s create-user-provided-service oracle-db-mine '{\"username\":\"admin\",\"password\":\"pa55woRD\"}'",
+	"app.create_user_usage":                        "%s create-user USERNAME PASSWORD",
+	"app.delete_description":                       "Delete an app",
+	"app.delete_domain_description":                "Delete a domain",
+	"app.delete_domain_usage":                      "%s delete-domain DOMAIN",
+	"app.delete_org_description":                   "Delete an org",
+	"app.delete_org_usage":                         "%s delete-org ORG",
+	"app.delete_service_auth_token_description":    "Delete a service auth token",
+	"app.delete_service_auth_token_usage":          "%s delete-service-auth-token LABEL PROVIDER",
+	"app.delete_service_broker_description":        "Delete a service broker",
+	"app.delete_service_broker_usage":              "%s delete-service-broker SERVICE_BROKER",
+	"app.delete_service_description":               "Delete a service instance",
+	"app.delete_service_usage":                     "%s delete-service SERVICE",
+	"app.delete_space_description":                 "Delete a space",
+	"app.delete_space_usage":                       "%s delete-space SPACE",
+	"app.delete_usage":                             "%s delete -f APP",
+	"app.delete_user_description":                  "Delete a user",
+	"app.delete_user_usage":                        "%s delete-user USERNAME",
+	"app.domains_description":                      "List domains in the target org",
+	"app.domains_usage":                            "%s domains",
+	"app.env_description":                          "Show all env variables for an app",
+	"app.env_usage":                                "%s env APP",
+	"app.events_description":                       "Show recent app events",
+	"app.events_usage":                             "%s events APP",
+	"app.files_description":                        "Print out a list of files in a directory or the contents of a specific file",
+	"app.files_usage":                              "%s files APP [PATH]",
+	"app.filter_flag":                              "Only show table rows where COLUMN=PATTERN, with * and ? wildcards",
+	"app.force_flag":                               "Force deletion without confirmation",
+	"app.hostname_flag":                            "Hostname",
+	"app.login_description":                        "Log user in",
+	"app.login_examples":                           "EXAMPLE:\n   %s login (omit username and password to login interactively -- %s will prompt for both)\n   %s login -u name@example.com -p pa55woRD (specify username and password to login non-interactively)\n   %s login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   %s login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   %s login -u name@example.com -p pa55woRD -o my-org -s my-space (log in and target an org and space)\n   %s login --sso (log in with a one-time passcode from your single sign-on provider)\n\nTIP:\n   CF_USERNAME and CF_PASSWORD are used when the username and password are not given",
+	"app.login_password_flag":                      "password",
+	"app.login_sso_flag":                           "log in with a one-time passcode",
+	"app.login_usage":                              "%s login [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso]\n\n",
+	"app.login_username_flag":                      "username",
+	"app.logout_description":                       "Log user out",
+	"app.logout_usage":                             "%s logout",
+	"app.logs_description":                         "Tail or show recent logs for an app",
+	"app.logs_recent_flag":                         "dump recent logs instead of tailing",
+	"app.logs_usage":                               "%s logs APP",
+	"app.map_domain_description":                   "Map a domain to a space",
+	"app.map_domain_usage":                         "%s map-domain SPACE DOMAIN",
+	"app.map_route_description":                    "Add a url route to an app",
+	"app.map_route_usage":                          "%s map-route APP DOMAIN [-n HOSTNAME]",
+	"app.marketplace_description":                  "List available offerings in the marketplace",
+	"app.marketplace_usage":                        "%s marketplace",
+	"app.oauth_token_description":                  "Retrieve and display the OAuth token for the current session",
+	"app.oauth_token_usage":                        "%s oauth-token",
+	"app.org_description":                          "Show org info",
+	"app.org_flag":                                 "organization",
+	"app.org_usage":                                "%s org ORG",
+	"app.orgs_description":                         "List all orgs",
+	"app.orgs_usage":                               "%s orgs",
+	"app.output_flag":                              "Output format for read commands: text, json or yaml",
+	"app.passwd_description":                       "Change user password",
+	"app.passwd_usage":                             "%s passwd",
+	"app.password_warning":                         "WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\n",
+	"app.profile_description":                      "Manage named configuration profiles",
+	"app.profile_usage":                            "%s profile list\n   %s profile create PROFILE\n   %s profile use PROFILE\n   %s profile delete PROFILE\n\nTIP:\n   Each profile keeps its own target, login, org and space. Set CF_PROFILE to use a profile for a single command",
+	"app.push_buildpack_flag":                      "Custom buildpack URL (for example: https://github.com/heroku/heroku-buildpack-play.git)",
+	"app.push_command_flag":                        "Startup command",
+	"app.push_description":                         "Push a new app or sync changes to an existing app",
+	"app.push_domain_flag":                         "Domain (for example: example.com)",
+	"app.push_hostname_flag":                       "Hostname (for example: my-subdomain)",
+	"app.push_instances_flag":                      "Number of instances",
+	"app.push_memory_flag":                         "Memory limit (for example: 256, 1G, 1024M)",
+	"app.push_no_restart_flag":                     "Do not restart an app after pushing",
+	"app.push_no_route_flag":                       "Do not map a route to this app",
+	"app.push_no_start_flag":                       "Do not start an app after pushing",
+	"app.push_path_flag":                           "Path of app directory or zip file",
+	"app.push_stack_flag":                          "Stack to use",
+	"app.push_usage":                               "%s push APP [-d DOMAIN] [-n HOST] [-i NUM_INSTANCES]\n               [-m MEMORY] [-b URL] [--no-[re]start] [--no-route] [-p PATH]\n               [-s STACK] [-c COMMAND]",
+	"app.quiet_flag":                               "Only print results, warnings and errors",
+	"app.rename_description":                       "Rename an app",
+	"app.rename_org_description":                   "Rename an org",
+	"app.rename_org_usage":                         "%s rename-org ORG NEW_ORG",
+	"app.rename_service_broker_description":        "Rename a service broker",
+	"app.rename_service_broker_usage":              "%s rename-service-broker SERVICE_BROKER NEW_SERVICE_BROKER",
+	"app.rename_service_description":               "Rename a service instance",
+	"app.rename_service_usage":                     "%s rename-service SERVICE_INSTANCE NEW_SERVICE_INSTANCE",
+	"app.rename_space_description":                 "Rename a space",
+	"app.rename_space_usage":                       "%s rename-space SPACE NEW_SPACE",
+	"app.rename_usage":                             "%s rename APP NEW_APP",
+	"app.reserve_domain_description":               "Reserve a domain on an org for later use",
+	"app.reserve_domain_usage":                     "%s reserve-domain ORG DOMAIN",
+	"app.reserve_route_description":                "Reserve a url route on a space for later use",
+	"app.reserve_route_usage":                      "%s reserve-route SPACE DOMAIN [-n HOSTNAME]",
+	"app.restart_description":                      "Restart an app",
+	"app.restart_usage":                            "%s restart APP",
+	"app.routes_description":                       "List all routes",
+	"app.routes_usage":                             "%s routes",
+	"app.scale_description":                        "Change the disk quota, instance count, and memory limit for an app",
+	"app.scale_disk_flag":                          "disk quota",
+	"app.scale_instances_flag":                     "number of instances",
+	"app.scale_memory_flag":                        "memory limit",
+	"app.scale_usage":                              "%s scale APP -d DISK -i INSTANCES -m MEMORY",
+	"app.service_auth_tokens_description":          "List service auth tokens",
+	"app.service_auth_tokens_usage":                "%s service-auth-tokens",
+	"app.service_brokers_description":              "List service brokers",
+	"app.service_brokers_usage":                    "%s service-brokers",
+	"app.service_description":                      "Show service instance info",
+	"app.service_usage":                            "%s service SERVICE_INSTANCE",
+	"app.services_description":                     "List all services in the target space",
+	"app.services_usage":                           "%s services",
+	"app.set_env_description":                      "Set an env variable for an app",
+	"app.set_env_usage":                            "%s set-env APP NAME VALUE",
+	"app.set_org_role_description":                 "Assign an org role to a user",
+	"app.set_org_role_usage":                       "%s set-org-role USERNAME ORG ROLE\n\nROLES:\n   OrgManager - Invite and manage users, select and change plans, and set spending limits\n   BillingManager - Create and manage the billing account and payment info\n   OrgAuditor - View logs, reports, and settings on this org and all spaces\n",
+	"app.set_quota_description":                    "Define the quota for an org",
+	"app.set_quota_usage":                          "%s set-quota ORG QUOTA\n\nTIP:\n   Allowable quotas are 'free,' 'paid,' 'runaway,' and 'trial'",
+	"app.set_space_role_description":               "Assign a space role to a user",
+	"app.set_space_role_usage":                     "%s set-space-role USERNAME ORG SPACE ROLE\n\nROLES:\n   SpaceManager - Invite and manage users, and enable features for a given space\n   SpaceDeveloper - Create and manage apps and services, and see logs and reports\n   SpaceAuditor - View logs, reports, and settings on this space\n",
+	"app.sort_flag":                                "Sort tables by a column, or by -COLUMN in descending order",
+	"app.space_description":                        "Show target space's info",
+	"app.space_flag":                               "space",
+	"app.space_usage":                              "%s space",
+	"app.spaces_description":                       "List all spaces in an org",
+	"app.spaces_usage":                             "%s spaces",
+	"app.stacks_description":                       "List all stacks",
+	"app.stacks_usage":                             "%s stacks",
+	"app.start_description":                        "Start an app",
+	"app.start_usage":                              "%s start APP",
+	"app.stop_description":                         "Stop an app",
+	"app.stop_usage":                               "%s stop APP",
+	"app.target_description":                       "Set or view the targeted org or space",
+	"app.target_usage":                             "%s target [-o ORG] [-s SPACE]",
+	"app.unbind_service_description":               "Unbind a service instance from an app",
+	"app.unbind_service_usage":                     "%s unbind-service APP SERVICE_INSTANCE",
+	"app.unmap_domain_description":                 "Unmap a domain from a space",
+	"app.unmap_domain_usage":                       "%s unmap-domain SPACE DOMAIN",
+	"app.unmap_route_description":                  "Remove a url route from an app",
+	"app.unmap_route_usage":                        "%s unmap-route APP DOMAIN [-n HOSTNAME]",
+	"app.unset_env_description":                    "Remove an env variable",
+	"app.unset_env_usage":                          "%s unset-env APP NAME",
+	"app.unset_org_role_description":               "Remove an org role from a user",
+	"app.unset_org_role_usage":                     "%s unset-org-role USERNAME ORG ROLE",
+	"app.unset_space_role_description":             "Remove a space role from a user",
+	"app.unset_space_role_usage":                   "%s unset-space-role USERNAME ORG SPACE ROLE",
+	"app.update_service_auth_token_description":    "Update a service auth token",
+	"app.update_service_auth_token_usage":          "%s update-service-auth-token LABEL PROVIDER TOKEN",
+	"app.update_service_broker_description":        "Update a service broker",
+	"app.update_service_broker_usage":              "%s update-service-broker SERVICE_BROKER USERNAME PASSWORD URL",
+	"app.update_user_provided_service_description": "Update user-provided service name value pairs",
+	"app.update_user_provided_service_usage":       "%s update-user-provided-service SERVICE_INSTANCE '{\"name\":\"value\",\"name\":\"value\"}'\n\nEXAMPLE:\n   %s update-user-provided-service oracle-db-mine '{\"username\":\"admin\",\"password\":\"pa55woRD\"}'",
+	"app.usage":                                    "A command line tool to interact with Cloud Foundry",
+	"app.whoami_description":                       "Show the user, client and scopes of the current session",
+	"app.whoami_usage":                             "%s whoami",
+	"app.wide_flag":                                "Print tables in full instead of truncating them to the terminal width",
+
+	"application_bits.error_copying_files":             "Error copying files to temp directory",
+	"application_bits.error_creating_upload":           "Error creating upload",
+	"application_bits.error_creating_upload_directory": "Error creating upload directory",
+	"application_bits.error_extracting_archive":        "Error extracting archive",
+	"application_bits.error_listing_files":             "Error listing app files",
+	"application_bits.error_matching_resources":        "Failed to create json for resource_match request",
+	"application_bits.upload_failed":                   "Failed to complete upload.",
+
+	"applications.error_creating_json":       "Error creating json",
+	"applications.error_generating_body":     "Error generating body",
+	"applications.error_serializing_updates": "Could not serialize app updates.",
+	"applications.invalid_name":              "App name is invalid: name can only contain letters, numbers, underscores and hyphens",
+
+	"auth.api_endpoint":    "API endpoint: %s",
+	"auth.authenticating":  "Authenticating...",
+	"auth.view_target_tip": "Use '%s' to view or set your target org and space",
+
+	"authentication.client_credentials_incorrect": "Client credentials are incorrect, please try again.",
+	"authentication.error_saving_config":          "Error setting configuration",
+	"authentication.passcode_incorrect":           "Passcode is incorrect or has expired, please try again.",
+	"authentication.password_incorrect":           "Password is incorrect, please try again.",
+	"authentication.server_error":                 "Authentication Server error: %s",
+	"authentication.session_expired":              "Your session has expired.",
+
+	"bind_service.already_bound": "App %s is already bound to %s.",
+	"bind_service.binding":       "Binding service %s to %s...",
+	"bind_service.push_tip":      "TIP: Use 'cf push' to ensure your env variable changes take effect",
+
+	"config.alias_is_a_command":    "%s is already a command and cannot be used as an alias",
+	"config.alias_not_found":       "Alias %s does not exist.",
+	"config.alias_unknown_command": "Unknown command %s. Aliases must start with a command.",
+	"config.current_language":      "%s (current)",
+	"config.default_not_found":     "No default is set for %s -%s.",
+	"config.getting_aliases":       "Getting aliases...",
+	"config.getting_languages":     "Getting languages...",
+	"config.getting_defaults":      "Getting default flag values...",
+	"config.no_aliases_defined":    "No aliases defined",
+	"config.no_defaults":           "No default flag values defined",
+	"config.removing_alias":        "Removing alias %s...",
+	"config.removing_language":     "Removing the language setting, the language of your locale will be used...",
+	"config.removing_default":      "Removing default for %s -%s...",
+	"config.setting_alias":         "Setting alias %s to %s...",
+	"config.setting_language":      "Setting language to %s...",
+	"config.setting_default":       "Setting default for %s -%s to %s...",
+	"config.unknown_command":       "Unknown command %s",
+	"config.unknown_language":      "Unknown language %s. Use one of: %s",
+	"config.unknown_flag":          "The %s command has no -%s flag",
+
+	"create_org.creating_org":       "Creating org %s...",
+	"create_org.org_already_exists": "Org %s already exists",
+	"create_org.target_tip":         "\nTIP: Use '%s' to target new org",
+
+	"create_service.creating_service":       "Creating service %s...",
+	"create_service.offering_not_found":     "Could not find offering with name %s",
+	"create_service.plan_not_found":         "Could not find plan with name %s",
+	"create_service.service_already_exists": "Service %s already exists",
+
+	"create_service_auth_token.creating": "Creating service auth token...",
+
+	"create_service_broker.creating": "Creating service broker %s...",
+
+	"create_space.creating_space":       "Creating space %s...",
+	"create_space.space_already_exists": "Space %s already exists",
+	"create_space.target_tip":           "\nTIP: Use '%s' to target new space",
+
+	"create_user.creating_user":       "Creating user %s...",
+	"create_user.error_creating_user": "Error creating user %s.\n%s",
+	"create_user.roles_tip":           "\nTIP: Assign roles with '%s set-org-role' and '%s set-space-role'",
+
+	"create_user_provided_service.creating": "Creating user provided service...",
+
+	"delete_app.app_not_found": "App %s does not exist.",
+	"delete_app.confirm":       "Really delete %s?%s",
+	"delete_app.deleting_app":  "Deleting app %s...",
+
+	"delete_domain.confirm":               "Are you sure you want to delete the domain %s and all of its associations?",
+	"delete_domain.confirm_shared":        "This domain is shared across all orgs.\nDeleting it will remove all associated routes, and will make any app with this domain unreachable.\nAre you sure you want to delete the domain %s? ",
+	"delete_domain.deleting_domain":       "Deleting domain %s...",
+	"delete_domain.error_deleting_domain": "Error deleting domain %s\n%s",
+	"delete_domain.error_finding_domain":  "Error finding domain %s\n%s",
+
+	"delete_org.confirm":                "Really delete org %s and everything associated with it?%s",
+	"delete_org.could_not_reset_target": "Couldn't reset your target. You should logout and log in again.",
+	"delete_org.deleting_org":           "Deleting org %s...",
+	"delete_org.org_not_found":          "Org %s does not exist.",
+
+	"delete_service.deleting_service":  "Deleting service %s...",
+	"delete_service.service_not_found": "Service %s does not exist.",
+
+	"delete_service_auth_token.confirm":         "Are you sure you want to delete %s?%s",
+	"delete_service_auth_token.deleting":        "Deleting service auth token...",
+	"delete_service_auth_token.error_deleting":  "Error deleting service auth token.\n%s",
+	"delete_service_auth_token.token_not_found": "Service Auth Token %s %s does not exist.",
+
+	"delete_service_broker.broker_not_found": "Service Broker %s does not exist.",
+	"delete_service_broker.confirm":          "Really delete %s?%s",
+	"delete_service_broker.deleting":         "Deleting service broker %s...",
+
+	"delete_space.confirm":               "Really delete space %s and everything associated with it?%s",
+	"delete_space.deleting_space":        "Deleting space %s...",
+	"delete_space.no_space_targeted_tip": "TIP: No space targeted, use '%s target -s' to target a space",
+	"delete_space.space_not_found":       "Space %s does not exist.",
+
+	"delete_user.confirm":        "Really delete user %s?%s",
+	"delete_user.deleting_user":  "Deleting user %s...",
+	"delete_user.user_not_found": "User %s does not exist.",
+
+	"domain_mapper.error_finding_domain": "Error finding domain %s\n%s",
+	"domain_mapper.mapping":              "Mapping domain %s to space %s...",
+	"domain_mapper.unmapping":            "Unmapping domain %s from space %s...",
+
+	"endpoints.invalid_scheme":      "API endpoints should start with https:// or http://",
+	"endpoints.missing_from_config": "Endpoint missing from config file",
+
+	"env.getting_variables": "Getting env variables for %s...",
+	"env.no_variables":      "No env variables exist",
+
+	"events.error_fetching_events": "Failed fetching events.\n%s",
+	"events.getting_events":        "Getting events for %s...",
+	"events.no_events":             "There are no events available for %s at this time",
+	"events.showing_all_events":    "Showing all %d event(s)...\n",
+
+	"factory.command_not_found": "Command not found",
+
+	"files.getting_files": "Getting files...",
+
+	"gateway.error_building_request":   "Error building request",
+	"gateway.error_performing_request": "Error performing request",
+	"gateway.error_reading_response":   "Error reading response",
+	"gateway.invalid_json_response":    "Invalid JSON response from server",
+
+	"helpers.invalid_byte_string": "Could not parse byte string",
+
+	"list_apps.getting_apps": "Getting apps in %s...",
+
+	"list_domains.getting_domains": "Getting domains in org %s...",
+
+	"list_orgs.getting_orgs": "Getting orgs...",
+
+	"list_routes.getting_routes":  "Getting routes in space %s...",
+	"list_routes.no_routes_found": "No routes found",
+
+	"list_service_auth_tokens.getting_tokens": "Getting service auth tokens...",
+
+	"list_service_brokers.getting_brokers": "Getting service brokers...",
+	"list_service_brokers.no_brokers":      "No service brokers found",
+
+	"list_services.getting_services": "Getting services in %s...",
+
+	"list_spaces.getting_spaces": "Getting spaces in %s...",
+
+	"login.api_endpoint":           "API endpoint: %s",
+	"login.authenticating":         "Authenticating...",
+	"login.could_not_target_org":   "Could not target org.\n%s",
+	"login.could_not_target_space": "Unable to access space %s.\n%s",
+	"login.error_fetching_login":   "Error fetching login prompts.\n%s",
+	"login.missing_prompt":         "No value for %s. It can only be asked for from a terminal.",
+	"login.missing_username":       "Missing username. Use -u USERNAME or set CF_USERNAME when there is no terminal.",
+	"login.one_time_passcode":      "Get a one-time passcode at %s",
+	"login.org_required_for_space": "An org must be targeted before targeting a space",
+	"login.password_prompt":        "Password",
+	"login.sso_not_supported":      "The login server does not support single sign-on.",
+	"login.targeting_org":          "Targeting org %s...",
+	"login.targeting_space":        "Targeting space %s...",
+	"login.username_prompt":        "Username",
+	"login.view_target_tip":        "Use '%s' to view or set your target org and space",
+
+	"logout.logging_out": "Logging out...",
+
+	"logs.connected_recent":  "Connected, dumping recent logs...",
+	"logs.connected_tailing": "Connected, tailing...",
+
+	"main.app_help_template":     "NAME:\n   {{.Name}} - {{.Usage}}\n\nUSAGE:\n   [environment variables] {{.Name}} [global options] command [arguments...] [command options]\n\nVERSION:\n   {{.Version}}\n\nCOMMANDS:\n   {{range .Commands}}{{.Name}}{{with .ShortName}}, {{.}}{{end}}{{ \"\\t\" }}{{.Description}}\n   {{end}}\nGLOBAL OPTIONS:\n   {{range .Flags}}{{.}}\n   {{end}}\nENVIRONMENT VARIABLES:\n   CF_TRACE=true - will output HTTP requests and responses to stderr during command\n   CF_TRACE=path/to/trace.log - will append HTTP requests and responses to the given file\n   CF_MAX_REDIRECTS=10 - maximum number of HTTP redirects to follow\n   CF_HOME=path/to/dir - store the .cf configuration directory in the given directory instead of $HOME\n   CF_PROFILE=name - use the given configuration profile instead of the current one\n   CF_TOKEN_PASSPHRASE=passphrase - encrypt stored access tokens with the given passphrase\n   CF_COLOR=false - disable colored output, which is otherwise only used when stdout is a terminal\n   LANG=fr_FR.UTF-8 - show messages in the language of the locale (LC_ALL and LC_MESSAGES take precedence), unless one is set with 'cf config language'\n   NO_COLOR=1 - same as CF_COLOR=false\n   HTTP_PROXY=http://proxy.example.com:8080 - set to your proxy\n\nMACHINE-READABLE OUTPUT:\n   {{.Name}} --output json apps - apps, app, services, routes, domains, orgs, spaces, events and marketplace\n   print their results as JSON or YAML on stdout, with progress messages on stderr\n\nEXIT CODES:\n   0 success, 1 general failure, 2 incorrect usage, 3 not logged in or not authorized,\n   4 resource not found, 5 server or network error\n",
+	"main.command_help_template": "NAME:\n   {{.Name}} - {{.Description}}\n{{with .ShortName}}\nALIAS:\n   {{.}}\n{{end}}\nUSAGE:\n   {{.Usage}}{{with .Flags}}\n\nOPTIONS:\n   {{range .}}{{.}}\n   {{end}}{{else}}\n{{end}}",
+	"main.error_loading_config":  "Error loading config. Please reset target (%s) and log in (%s).",
+
+	"marketplace_services.getting_services": "Getting services from marketplace...",
+
+	"migrations.unsupported_version": "The config file was written by a newer version of cf (config version %d, this version supports up to %d). Please upgrade cf.",
+
+	"oauth_token.getting_oauth_token": "Getting OAuth token...",
+
+	"password.changing_password":         "Changing password...",
+	"password.current_password":          "Current Password%s",
+	"password.current_password_mismatch": "Current password did not match",
+	"password.new_password":              "New Password%s",
+	"password.please_log_in":             "Please log in again",
+	"password.strength":                  "Your password strength is: %s",
+	"password.verification_mismatch":     "Password verification does not match",
+	"password.verify_password":           "Verify Password%s",
+
+	"profile.creating_profile":     "Creating profile %s...",
+	"profile.current":              "%s (current)",
+	"profile.deleting_profile":     "Deleting profile %s...",
+	"profile.getting_profiles":     "Getting profiles...",
+	"profile.switching_to_profile": "Switching to profile %s...",
+
+	"profiles.already_exists":        "Profile %s already exists",
+	"profiles.cannot_delete_default": "The default profile cannot be deleted",
+	"profiles.in_use":                "Profile %s is in use, switch to another profile before deleting it",
+	"profiles.invalid_name":          "Invalid profile name %s. Profile names may only contain letters, numbers, dashes and underscores",
+	"profiles.not_found":             "Profile %s does not exist",
+	"profiles.unknown_profile":       "Profile %s does not exist. Create it with '%s profile create %s'",
+
+	"push.binding_route":   "Binding %s to %s...",
+	"push.creating":        "Creating %s...",
+	"push.creating_route":  "Creating route %s...",
+	"push.incorrect_usage": "Incorrect Usage",
+	"push.uploading":       "Uploading %s...",
+	"push.using_route":     "Using route %s",
+	"push.using_stack":     "Using stack %s...",
+
+	"rename_app.renaming": "Renaming %s to %s...",
+
+	"rename_org.renaming": "Renaming org %s...",
+
+	"rename_service.renaming":     "Renaming service %s...",
+	"rename_service.services_tip": "%s\nTIP: Use '%s services' to view all services in this org and space.",
+
+	"rename_service_broker.renaming": "Renaming service broker %s...",
+
+	"rename_space.renaming": "Renaming space %s...",
+
+	"reserve_domain.map_domain_tip": "TIP: Use '%s map-domain' to assign it to a space",
+	"reserve_domain.reserving":      "Reserving domain %s for org %s...",
+
+	"reserve_route.reserving": "Reserving url route %s for space %s...",
+
+	"route_mapper.adding_route":   "Adding url route %s to app %s...",
+	"route_mapper.removing_route": "Removing url route %s from app %s...",
+
+	"routes.route_not_found": "Route not found",
+
+	"runner.log_in_again":              "Log in again now?%s",
+	"runner.table_options_with_output": "--sort, --filter and --columns only apply to tables and cannot be used with --output %s",
+	"runner.unknown_command":           "'%s' is not a registered command. See '%s help'",
+
+	"scale.incorrect_usage":    "Incorrect Usage",
+	"scale.invalid_disk_quota": "Invalid value for disk quota",
+	"scale.invalid_memory":     "Invalid value for memory",
+	"scale.scaling":            "Scaling app %s...",
+
+	"scopes.admin_purpose":           "admin commands such as create-org, delete-org, create-user, delete-user and the service auth token commands",
+	"scopes.admin_scope_not_granted": "Scope %s is not granted, it is only needed for %s",
+	"scopes.missing_scope":           "Missing scope %s, which is needed for %s",
+	"scopes.read_purpose":            "viewing apps, services, orgs and spaces",
+	"scopes.write_purpose":           "creating and changing apps, services, orgs and spaces",
+
+	"services.apps_still_bound":       "Cannot delete service instance, apps are still bound to it",
+	"services.error_parsing_response": "Error parsing response",
+
+	"set_env.already_set": "Env var %s was already set.",
+	"set_env.push_tip":    "TIP: Use '%s push' to ensure your env variable changes take effect",
+	"set_env.updating":    "Updating env variable %s for app %s...",
+
+	"set_org_role.assigning_role": "Assigning %s role to %s in %s org...",
+
+	"set_quota.setting_quota": "Setting quota %s to org %s...",
+
+	"set_space_role.assigning_role": "Assigning %s role to %s in %s space in %s org...",
+
+	"share_domain.sharing": "Sharing domain %s...",
+
+	"show_app.instances":      "%s %s x %d instances",
+	"show_app.showing_health": "Showing health and status for app %s...",
+	"show_app.usage_of_quota": "%s of %s",
+
+	"show_org.domains":      "  domains: %s",
+	"show_org.getting_info": "Getting info for org %s...",
+	"show_org.spaces":       "  spaces: %s",
+
+	"show_service.description":       "Description: %s",
+	"show_service.documentation_url": "Documentation url: %s",
+	"show_service.plan":              "Plan: %s",
+	"show_service.service":           "Service: %s",
+	"show_service.service_instance":  "Service instance: %s",
+
+	"show_space.apps":         "  Apps: %s",
+	"show_space.domains":      "  Domains: %s",
+	"show_space.getting_info": "Getting info for space %s...",
+	"show_space.org":          "  Org: %s",
+	"show_space.services":     "  Services: %s",
+
+	"stacks.getting_stacks":  "Getting stacks...",
+	"stacks.stack_not_found": "Stack %s not found",
+
+	"start.already_started":    "App %s is already started",
+	"start.instances_down":     "%d down",
+	"start.instances_running":  "%d of %d instances running (%s)",
+	"start.instances_starting": "%d starting",
+	"start.started":            "Started",
+	"start.started_at_url":     "Started: app %s available at %s",
+	"start.starting":           "Starting %s...",
+	"start.timeout":            "Start app timeout",
+	"start.unsuccessful":       "Start unsuccessful",
+
+	"stop.already_stopped": "App %s is already stopped",
+	"stop.stopping":        "Stopping %s...",
+
+	"suggestions.did_you_mean": "Did you mean %s?",
+
+	"target.could_not_target_org":     "Could not target org.\n%s",
+	"target.could_not_target_space":   "Unable to access space %s.\n%s",
+	"target.login_required_for_org":   "You must be logged in to target an org. Use '%s login'.",
+	"target.login_required_for_space": "You must be logged in to set a space. Use '%s login'.",
+	"target.no_org_targeted":          "No org targeted, use '%s target -o' to target an org",
+	"target.no_space_targeted":        "No space targeted, use '%s target -s' to target a space",
+	"target.org_required_for_space":   "An org must be targeted before targeting a space",
+
+	"target_selector.error_finding_orgs":   "Error finding available orgs.\n%s",
+	"target_selector.error_finding_spaces": "Error finding available spaces.\n%s",
+	"target_selector.org_not_listed":       "%s is not one of the listed orgs.",
+	"target_selector.org_prompt":           "Org%s",
+	"target_selector.select_org":           "Select the org to target (or press Enter to skip):",
+	"target_selector.select_space":         "Select the space to target (or press Enter to skip):",
+	"target_selector.space_not_listed":     "%s is not one of the listed spaces.",
+	"target_selector.space_prompt":         "Space%s",
+	"target_selector.targeted_org":         "Targeted org %s",
+	"target_selector.targeted_space":       "Targeted space %s",
+
+	"targeted_organization.no_org_targeted": "No org targeted, use '%s' to target an org.",
+
+	"targeted_space.no_org_and_space_targeted": "No org and space targeted, use '%s' to target an org and space",
+	"targeted_space.no_space_targeted":         "No space targeted, use '%s' to target a space",
+
+	"terminal.api_endpoint":           "API endpoint: %s (API version: %s)",
+	"terminal.client":                 "Client:       %s",
+	"terminal.confirm_answers":        "y,yes",
+	"terminal.error_loading_config":   "Error loading config. Please reset the api '%s' and log in '%s'.\n%s",
+	"terminal.error_rendering_output": "Error rendering %s output.\n%s",
+	"terminal.failed":                 "FAILED",
+	"terminal.incorrect_usage":        "Incorrect Usage.",
+	"terminal.invalid_filter":         "Invalid filter %s. Use field=pattern, for example state=stopped",
+	"terminal.logged_out":             "Logged out, use '%s' to login",
+	"terminal.no_renderer":            "No renderer for output format %s",
+	"terminal.not_logged_in":          "Not logged in. Use '%s' to log in.",
+	"terminal.ok":                     "OK",
+	"terminal.org":                    "Org:          %s",
+	"terminal.space":                  "Space:        %s",
+	"terminal.unknown_field":          "Unknown field %s. Use one of: %s",
+	"terminal.unknown_output_format":  "Unknown output format %s. Use one of: text, json, yaml",
+	"terminal.user":                   "User:         %s",
+
+	"token.missing_type": "Access token is missing its type",
+	"token.no_expiry":    "Access token has no expiry",
+	"token.not_a_jwt":    "Access token is not a JSON web token",
+
+	"token_store.corrupt_file":     "Could not decrypt the stored tokens. The token file is corrupt.",
+	"token_store.wrong_passphrase": "Could not decrypt the stored tokens. Check the value of CF_TOKEN_PASSPHRASE.",
+
+	"unbind_service.binding_not_found": "Binding between %s and %s did not exist",
+	"unbind_service.unbinding":         "Unbinding service %s from %s...",
+
+	"unset_env.not_set":  "Env variable %s was not set.",
+	"unset_env.push_tip": "TIP: Use '%s push' to ensure your env variable changes take effect",
+	"unset_env.removing": "Removing env variable %s for app %s...",
+
+	"unset_org_role.removing_role": "Removing %s role from %s in %s org...",
+
+	"unset_space_role.removing_role": "Removing %s role from %s in %s space in %s org...",
+
+	"update_service_auth_token.updating": "Updating service auth token...",
+
+	"update_service_broker.updating": "Updating service broker %s...",
+
+	"update_user_provided_service.invalid_json":      "JSON is invalid: %s",
+	"update_user_provided_service.not_user_provided": "Service Instance is not user provided",
+	"update_user_provided_service.updating":          "Updating user provided service %s...",
+
+	"users.invalid_role": "Invalid Role %s",
+
+	"whoami.client":               "Client:     %s",
+	"whoami.could_not_read_token": "Could not read the access token.\n%s",
+	"whoami.email":                "Email:      %s",
+	"whoami.expired":              "Expires:    %s (expired, it will be refreshed on the next request)",
+	"whoami.expires":              "Expires:    %s",
+	"whoami.issuer":               "Issuer:     %s",
+	"whoami.never_expires":        "Expires:    never",
+	"whoami.scopes":               "Scopes:     %s",
+	"whoami.user":                 "User:       %s",
+	"whoami.user_id":              "User id:    %s",
+}
@@ -0,0 +1,180 @@
+package i18n
+
+var french = map[string]string{
+	"api.api_endpoint":          "Point d'accès API : %s (version de l'API : %s)",
+	"api.setting_api_endpoint":  "Définition du point d'accès API à %s...",
+	"api.warning_insecure_http": "\nAttention : point d'accès API http non sécurisé : les points d'accès API https sont recommandés\n",
+
+	"app.api_description":                          "Définir ou afficher l'URL de l'API ciblée",
+	"app.app_description":                          "Afficher l'état et le statut d'une app",
+	"app.apps_description":                         "Lister toutes les apps de l'espace ciblé",
+	"app.auth_description":                         "Authentifier un utilisateur ou un client de manière non interactive",
+	"app.bind_service_description":                 "Lier une instance de service à une app",
+	"app.columns_flag":                             "Liste des colonnes de tableau à afficher, séparées par des virgules",
+	"app.completion_description":                   "Afficher un script de complétion pour bash, zsh ou fish",
+	"app.config_description":                       "Gérer les alias de commandes, les valeurs par défaut des options et la langue des messages",
+	"app.create_org_description":                   "Créer une org",
+	"app.create_service_auth_token_description":    "Créer un jeton d'authentification de service",
+	"app.create_service_broker_description":        "Créer un courtier de services",
+	"app.create_service_description":               "Créer une instance de service",
+	"app.create_space_description":                 "Créer un espace",
+	"app.create_user_description":                  "Créer un nouvel utilisateur",
+	"app.create_user_provided_service_description": "Mettre un service fourni par l'utilisateur à la disposition des apps cf",
+	"app.delete_description":                       "Supprimer une app",
+	"app.delete_domain_description":                "Supprimer un domaine",
+	"app.delete_org_description":                   "Supprimer une org",
+	"app.delete_service_auth_token_description":    "Supprimer un jeton d'authentification de service",
+	"app.delete_service_broker_description":        "Supprimer un courtier de services",
+	"app.delete_service_description":               "Supprimer une instance de service",
+	"app.delete_space_description":                 "Supprimer un espace",
+	"app.delete_user_description":                  "Supprimer un utilisateur",
+	"app.domains_description":                      "Lister les domaines de l'org ciblée",
+	"app.env_description":                          "Afficher toutes les variables d'environnement d'une app",
+	"app.events_description":                       "Afficher les événements récents d'une app",
+	"app.files_description":                        "Afficher la liste des fichiers d'un répertoire ou le contenu d'un fichier",
+	"app.filter_flag":                              "N'afficher que les lignes de tableau où COLUMN=PATTERN, avec les jokers * et ?",
+	"app.force_flag":                               "Forcer la suppression sans confirmation",
+	"app.login_description":                        "Connecter l'utilisateur",
+	"app.logout_description":                       "Déconnecter l'utilisateur",
+	"app.logs_description":                         "Suivre ou afficher les journaux récents d'une app",
+	"app.map_domain_description":                   "Associer un domaine à un espace",
+	"app.map_route_description":                    "Ajouter une route à une app",
+	"app.marketplace_description":                  "Lister les offres disponibles sur la place de marché",
+	"app.oauth_token_description":                  "Obtenir et afficher le jeton OAuth de la session en cours",
+	"app.org_description":                          "Afficher les informations d'une org",
+	"app.orgs_description":                         "Lister toutes les orgs",
+	"app.output_flag":                              "Format de sortie des commandes de lecture : text, json ou yaml",
+	"app.passwd_description":                       "Changer le mot de passe de l'utilisateur",
+	"app.profile_description":                      "Gérer les profils de configuration nommés",
+	"app.push_description":                         "Envoyer une nouvelle app ou synchroniser les modifications d'une app existante",
+	"app.quiet_flag":                               "N'afficher que les résultats, les avertissements et les erreurs",
+	"app.rename_description":                       "Renommer une app",
+	"app.rename_org_description":                   "Renommer une org",
+	"app.rename_service_broker_description":        "Renommer un courtier de services",
+	"app.rename_service_description":               "Renommer une instance de service",
+	"app.rename_space_description":                 "Renommer un espace",
+	"app.reserve_domain_description":               "Réserver un domaine dans une org pour un usage ultérieur",
+	"app.reserve_route_description":                "Réserver une route dans un espace pour un usage ultérieur",
+	"app.restart_description":                      "Redémarrer une app",
+	"app.routes_description":                       "Lister toutes les routes",
+	"app.scale_description":                        "Modifier le quota de disque, le nombre d'instances et la limite de mémoire d'une app",
+	"app.service_auth_tokens_description":          "Lister les jetons d'authentification de service",
+	"app.service_brokers_description":              "Lister les courtiers de services",
+	"app.service_description":                      "Afficher les informations d'une instance de service",
+	"app.services_description":                     "Lister tous les services de l'espace ciblé",
+	"app.set_env_description":                      "Définir une variable d'environnement pour une app",
+	"app.set_org_role_description":                 "Attribuer un rôle d'org à un utilisateur",
+	"app.set_quota_description":                    "Définir le quota d'une org",
+	"app.set_space_role_description":               "Attribuer un rôle d'espace à un utilisateur",
+	"app.sort_flag":                                "Trier les tableaux par une colonne, ou par -COLUMN en ordre décroissant",
+	"app.space_description":                        "Afficher les informations de l'espace ciblé",
+	"app.spaces_description":                       "Lister tous les espaces d'une org",
+	"app.stacks_description":                       "Lister toutes les piles",
+	"app.start_description":                        "Démarrer une app",
+	"app.stop_description":                         "Arrêter une app",
+	"app.target_description":                       "Définir ou afficher l'org ou l'espace ciblé",
+	"app.unbind_service_description":               "Délier une instance de service d'une app",
+	"app.unmap_domain_description":                 "Dissocier un domaine d'un espace",
+	"app.unmap_route_description":                  "Retirer une route d'une app",
+	"app.unset_env_description":                    "Supprimer une variable d'environnement",
+	"app.unset_org_role_description":               "Retirer un rôle d'org à un utilisateur",
+	"app.unset_space_role_description":             "Retirer un rôle d'espace à un utilisateur",
+	"app.update_service_auth_token_description":    "Mettre à jour un jeton d'authentification de service",
+	"app.update_service_broker_description":        "Mettre à jour un courtier de services",
+	"app.update_user_provided_service_description": "Mettre à jour les paires nom-valeur d'un service fourni par l'utilisateur",
+	"app.usage":                                    "Un outil en ligne de commande pour interagir avec Cloud Foundry",
+	"app.whoami_description":                       "Afficher l'utilisateur, le client et les scopes de la session en cours",
+	"app.wide_flag":                                "Afficher les tableaux en entier au lieu de les tronquer à la largeur du terminal",
+
+	"auth.api_endpoint":    "Point d'accès API : %s",
+	"auth.authenticating":  "Authentification...",
+	"auth.view_target_tip": "Utilisez '%s' pour afficher ou définir l'org et l'espace ciblés",
+
+	"authentication.client_credentials_incorrect": "Les identifiants du client sont incorrects, veuillez réessayer.",
+	"authentication.passcode_incorrect":           "Le code d'accès est incorrect ou a expiré, veuillez réessayer.",
+	"authentication.password_incorrect":           "Le mot de passe est incorrect, veuillez réessayer.",
+	"authentication.session_expired":              "Votre session a expiré.",
+
+	"config.alias_not_found":    "L'alias %s n'existe pas.",
+	"config.current_language":   "%s (actuelle)",
+	"config.getting_aliases":    "Récupération des alias...",
+	"config.getting_languages":  "Récupération des langues...",
+	"config.no_aliases_defined": "Aucun alias défini",
+	"config.removing_alias":     "Suppression de l'alias %s...",
+	"config.removing_language":  "Suppression du réglage de langue, la langue de votre locale sera utilisée...",
+	"config.setting_alias":      "Définition de l'alias %s pour %s...",
+	"config.setting_language":   "Définition de la langue à %s...",
+	"config.unknown_language":   "Langue inconnue %s. Utilisez l'une de : %s",
+
+	"delete_app.app_not_found": "L'app %s n'existe pas.",
+	"delete_app.confirm":       "Supprimer vraiment %s ?%s",
+	"delete_app.deleting_app":  "Suppression de l'app %s...",
+
+	"endpoints.invalid_scheme": "Les points d'accès API doivent commencer par https:// ou http://",
+
+	"list_apps.getting_apps": "Récupération des apps dans %s...",
+
+	"list_orgs.getting_orgs": "Récupération des orgs...",
+
+	"list_services.getting_services": "Récupération des services dans %s...",
+
+	"list_spaces.getting_spaces": "Récupération des espaces dans %s...",
+
+	"login.api_endpoint":           "Point d'accès API : %s",
+	"login.authenticating":         "Authentification...",
+	"login.could_not_target_org":   "Impossible de cibler l'org.\n%s",
+	"login.could_not_target_space": "Impossible d'accéder à l'espace %s.\n%s",
+	"login.missing_username":       "Nom d'utilisateur manquant. Utilisez -u USERNAME ou définissez CF_USERNAME en l'absence de terminal.",
+	"login.one_time_passcode":      "Obtenez un code d'accès à usage unique sur %s",
+	"login.password_prompt":        "Mot de passe",
+	"login.sso_not_supported":      "Le serveur de connexion ne prend pas en charge l'authentification unique.",
+	"login.targeting_org":          "Ciblage de l'org %s...",
+	"login.targeting_space":        "Ciblage de l'espace %s...",
+	"login.username_prompt":        "Nom d'utilisateur",
+	"login.view_target_tip":        "Utilisez '%s' pour afficher ou définir l'org et l'espace ciblés",
+
+	"logout.logging_out": "Déconnexion...",
+
+	"push.creating":       "Création de %s...",
+	"push.creating_route": "Création de la route %s...",
+	"push.uploading":      "Envoi de %s...",
+	"push.using_route":    "Utilisation de la route %s",
+
+	"runner.log_in_again":    "Se reconnecter maintenant ?%s",
+	"runner.unknown_command": "'%s' n'est pas une commande connue. Voir '%s help'",
+
+	"show_app.showing_health": "Affichage de l'état de l'app %s...",
+
+	"start.already_started":    "L'app %s est déjà démarrée",
+	"start.instances_down":     "%d arrêtées",
+	"start.instances_running":  "%d instances sur %d en cours d'exécution (%s)",
+	"start.instances_starting": "%d en démarrage",
+	"start.started":            "Démarrée",
+	"start.started_at_url":     "Démarrée : app %s disponible sur %s",
+	"start.starting":           "Démarrage de %s...",
+	"start.timeout":            "Délai de démarrage de l'app dépassé",
+	"start.unsuccessful":       "Échec du démarrage",
+
+	"stop.already_stopped": "L'app %s est déjà arrêtée",
+	"stop.stopping":        "Arrêt de %s...",
+
+	"suggestions.did_you_mean": "Vouliez-vous dire %s ?",
+
+	"targeted_organization.no_org_targeted":    "Aucune org ciblée, utilisez '%s' pour cibler une org.",
+	"targeted_space.no_org_and_space_targeted": "Aucune org ni aucun espace ciblés, utilisez '%s' pour cibler une org et un espace",
+	"targeted_space.no_space_targeted":         "Aucun espace ciblé, utilisez '%s' pour cibler un espace",
+
+	"terminal.api_endpoint":           "Point d'accès API : %s (version de l'API : %s)",
+	"terminal.client":                 "Client :      %s",
+	"terminal.confirm_answers":        "o,oui",
+	"terminal.error_loading_config":   "Erreur de chargement de la configuration. Redéfinissez l'API avec '%s' et connectez-vous avec '%s'.\n%s",
+	"terminal.error_rendering_output": "Erreur lors du rendu de la sortie %s.\n%s",
+	"terminal.failed":                 "ÉCHEC",
+	"terminal.incorrect_usage":        "Utilisation incorrecte.",
+	"terminal.logged_out":             "Déconnecté, utilisez '%s' pour vous connecter",
+	"terminal.not_logged_in":          "Non connecté. Utilisez '%s' pour vous connecter.",
+	"terminal.ok":                     "OK",
+	"terminal.org":                    "Org :         %s",
+	"terminal.space":                  "Espace :      %s",
+	"terminal.user":                   "Utilisateur : %s",
+}
@@ -0,0 +1,88 @@
+package i18n
+
+import (
+	"os"
+	"sort"
+	"strings"
+)
+
+// Messages are fmt formats looked up by ID in the catalogue of the current
+// language, falling back to English for any message not translated yet. A
+// translation keeps the arguments of the English message in the same order.
+// Table headers stay in English, as --sort, --filter and --columns name them.
+const DefaultLanguage = "en"
+
+var catalogues = map[string]map[string]string{
+	"en": english,
+	"fr": french,
+}
+
+var language = DefaultLanguage
+
+func T(id string) string {
+	if message, found := catalogues[language][id]; found {
+		return message
+	}
+	if message, found := english[id]; found {
+		return message
+	}
+	return id
+}
+
+func Language() string {
+	return language
+}
+
+// Switches to the catalogue for a language, or back to English when there is
+// none for it.
+func SetLanguage(lang string) {
+	language = DefaultLanguage
+	if _, found := catalogues[lang]; found {
+		language = lang
+	}
+}
+
+func Languages() (langs []string) {
+	for lang := range catalogues {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return
+}
+
+func IsLanguage(lang string) bool {
+	_, found := catalogues[lang]
+	return found
+}
+
+// The language set in the config takes precedence over the locale, which is
+// read from the environment in the usual order: LC_ALL, LC_MESSAGES, LANG.
+func DetectLanguage(configured string) string {
+	if configured != "" {
+		return configured
+	}
+
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if locale := os.Getenv(name); locale != "" {
+			return LanguageOfLocale(locale)
+		}
+	}
+	return DefaultLanguage
+}
+
+// The language part of a POSIX locale, so fr_CA.UTF-8@euro gives fr. The C and
+// POSIX locales are English.
+func LanguageOfLocale(locale string) string {
+	parts := strings.FieldsFunc(locale, func(r rune) bool {
+		return r == '_' || r == '-' || r == '.' || r == '@'
+	})
+	if len(parts) == 0 {
+		return DefaultLanguage
+	}
+
+	lang := strings.ToLower(parts[0])
+	if lang == "c" || lang == "posix" {
+		return DefaultLanguage
+	}
+	return lang
+}
@@ -0,0 +1,81 @@
+package i18n
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func TestTranslatesIntoTheCurrentLanguage(t *testing.T) {
+	defer SetLanguage(DefaultLanguage)
+
+	assert.Equal(t, T("terminal.failed"), "FAILED")
+
+	SetLanguage("fr")
+	assert.Equal(t, Language(), "fr")
+	assert.Equal(t, T("terminal.failed"), "ÉCHEC")
+}
+
+func TestFallsBackToEnglish(t *testing.T) {
+	defer SetLanguage(DefaultLanguage)
+
+	SetLanguage("fr")
+	assert.Equal(t, T("whoami.never_expires"), "Expires:    never")
+
+	SetLanguage("xx")
+	assert.Equal(t, Language(), "en")
+	assert.Equal(t, T("terminal.failed"), "FAILED")
+}
+
+func TestUnknownMessagesShowTheirID(t *testing.T) {
+	assert.Equal(t, T("no.such_message"), "no.such_message")
+}
+
+func TestDetectLanguage(t *testing.T) {
+	withLocale(map[string]string{"LC_ALL": "", "LC_MESSAGES": "", "LANG": ""}, func() {
+		assert.Equal(t, DetectLanguage(""), "en")
+		assert.Equal(t, DetectLanguage("fr"), "fr")
+	})
+
+	withLocale(map[string]string{"LC_ALL": "", "LC_MESSAGES": "", "LANG": "fr_FR.UTF-8"}, func() {
+		assert.Equal(t, DetectLanguage(""), "fr")
+		assert.Equal(t, DetectLanguage("en"), "en")
+	})
+
+	withLocale(map[string]string{"LC_ALL": "de_DE", "LC_MESSAGES": "", "LANG": "fr_FR.UTF-8"}, func() {
+		assert.Equal(t, DetectLanguage(""), "de")
+	})
+
+	withLocale(map[string]string{"LC_ALL": "", "LC_MESSAGES": "C", "LANG": "fr_FR.UTF-8"}, func() {
+		assert.Equal(t, DetectLanguage(""), "en")
+	})
+}
+
+func TestLanguageOfLocale(t *testing.T) {
+	assert.Equal(t, LanguageOfLocale("fr_CA.UTF-8@euro"), "fr")
+	assert.Equal(t, LanguageOfLocale("pt-BR"), "pt")
+	assert.Equal(t, LanguageOfLocale("DE"), "de")
+	assert.Equal(t, LanguageOfLocale("POSIX"), "en")
+	assert.Equal(t, LanguageOfLocale("."), "en")
+}
+
+func TestLanguages(t *testing.T) {
+	assert.Equal(t, Languages(), []string{"en", "fr"})
+	assert.True(t, IsLanguage("fr"))
+	assert.False(t, IsLanguage("xx"))
+}
+
+func withLocale(env map[string]string, block func()) {
+	previous := map[string]string{}
+	for name, value := range env {
+		previous[name] = os.Getenv(name)
+		os.Setenv(name, value)
+	}
+	defer func() {
+		for name, value := range previous {
+			os.Setenv(name, value)
+		}
+	}()
+
+	block()
+}
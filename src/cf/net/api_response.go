@@ -1,16 +1,21 @@
 package net
 
 import (
+	"cf"
 	"fmt"
+	"net/http"
 )
 
 type ApiResponse struct {
 	Message    string
 	ErrorCode  string
+	ErrorName  string
 	StatusCode int
+	RequestId  string
 
-	isError    bool
-	isNotFound bool
+	isError          bool
+	isNotFound       bool
+	isSessionExpired bool
 }
 
 func NewApiResponse(message string, errorCode string, statusCode int) (apiResponse ApiResponse) {
@@ -43,6 +48,14 @@ func NewNotFoundApiResponse(message string, a ...interface{}) (apiResponse ApiRe
 	}
 }
 
+func NewSessionExpiredApiResponse(message string, a ...interface{}) (apiResponse ApiResponse) {
+	return ApiResponse{
+		Message:          fmt.Sprintf(message, a...),
+		isError:          true,
+		isSessionExpired: true,
+	}
+}
+
 func (apiResponse ApiResponse) IsError() bool {
 	return apiResponse.isError
 }
@@ -51,6 +64,27 @@ func (apiResponse ApiResponse) IsNotFound() bool {
 	return apiResponse.isNotFound
 }
 
+func (apiResponse ApiResponse) IsSessionExpired() bool {
+	return apiResponse.isSessionExpired
+}
+
+// Returns nil for successful responses, and otherwise an error whose kind
+// tells missing resources, rejected credentials and other server failures apart.
+func (apiResponse ApiResponse) AsError() (err error) {
+	switch {
+	case apiResponse.IsSuccessful():
+		return nil
+	case apiResponse.IsNotFound():
+		return cf.NewCommandError(cf.NotFoundError, "%s", apiResponse.Message)
+	case apiResponse.IsSessionExpired():
+		return cf.NewCommandError(cf.SessionExpiredError, "%s", apiResponse.Message)
+	case apiResponse.StatusCode == http.StatusUnauthorized,
+		apiResponse.StatusCode == http.StatusForbidden:
+		return cf.NewCommandError(cf.AuthError, "%s", apiResponse.Message)
+	}
+	return cf.NewCommandError(cf.ServerError, "%s", apiResponse.Message)
+}
+
 func (apiResponse ApiResponse) IsSuccessful() bool {
 	return !apiResponse.IsNotSuccessful()
 }
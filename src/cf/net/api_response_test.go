@@ -0,0 +1,33 @@
+package net_test
+
+import (
+	"cf"
+	. "cf/net"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAsErrorForSuccessfulResponse(t *testing.T) {
+	assert.NoError(t, ApiResponse{}.AsError())
+}
+
+func TestAsErrorKinds(t *testing.T) {
+	err := NewNotFoundApiResponse("App %s not found", "my-app").AsError()
+	assert.Equal(t, cf.ErrorKindOf(err), cf.NotFoundError)
+	assert.Equal(t, err.Error(), "App my-app not found")
+
+	err = NewSessionExpiredApiResponse("Session expired").AsError()
+	assert.Equal(t, cf.ErrorKindOf(err), cf.SessionExpiredError)
+
+	err = NewApiResponse("Unauthorized", "10002", 401).AsError()
+	assert.Equal(t, cf.ErrorKindOf(err), cf.AuthError)
+
+	err = NewApiResponse("Forbidden", "10003", 403).AsError()
+	assert.Equal(t, cf.ErrorKindOf(err), cf.AuthError)
+
+	err = NewApiResponse("Internal server error", "10001", 500).AsError()
+	assert.Equal(t, cf.ErrorKindOf(err), cf.ServerError)
+
+	err = NewApiResponseWithMessage("Connection refused").AsError()
+	assert.Equal(t, cf.ErrorKindOf(err), cf.ServerError)
+}
@@ -12,6 +12,7 @@ func NewCloudControllerGateway() Gateway {
 
 	type ccErrorResponse struct {
 		Code        int
+		ErrorCode   string `json:"error_code"`
 		Description string
 	}
 
@@ -27,7 +28,7 @@ func NewCloudControllerGateway() Gateway {
 			code = INVALID_TOKEN_CODE
 		}
 
-		return errorResponse{Code: code, Description: ccResp.Description}
+		return errorResponse{Code: code, Name: ccResp.ErrorCode, Description: ccResp.Description}
 	}
 
 	return newGateway(errorHandler)
@@ -10,8 +10,9 @@ import (
 )
 
 var failingCloudControllerRequest = func(writer http.ResponseWriter, request *http.Request) {
+	writer.Header().Set("X-Vcap-Request-Id", "my-request-id")
 	writer.WriteHeader(http.StatusBadRequest)
-	jsonResponse := `{ "code": 210003, "description": "The host is taken: test1" }`
+	jsonResponse := `{ "code": 210003, "description": "The host is taken: test1", "error_code": "CF-RouteHostTaken" }`
 	fmt.Fprintln(writer, jsonResponse)
 }
 
@@ -29,6 +30,10 @@ func TestCloudControllerGatewayErrorHandling(t *testing.T) {
 	assert.True(t, apiResponse.IsNotSuccessful())
 	assert.Contains(t, apiResponse.Message, "The host is taken: test1")
 	assert.Contains(t, apiResponse.ErrorCode, "210003")
+	assert.Equal(t, apiResponse.ErrorName, "CF-RouteHostTaken")
+	assert.Equal(t, apiResponse.RequestId, "my-request-id")
+	assert.Contains(t, apiResponse.Message, "Error name: CF-RouteHostTaken")
+	assert.Contains(t, apiResponse.Message, "Request ID: my-request-id")
 }
 
 var invalidTokenCloudControllerRequest = func(writer http.ResponseWriter, request *http.Request) {
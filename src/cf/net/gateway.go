@@ -3,18 +3,27 @@ package net
 import (
 	"bytes"
 	"cf"
+	"cf/configuration"
+	"cf/i18n"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"runtime"
+	"time"
 )
 
-const INVALID_TOKEN_CODE = "GATEWAY INVALID TOKEN CODE"
+const (
+	INVALID_TOKEN_CODE = "GATEWAY INVALID TOKEN CODE"
+	REQUEST_ID_HEADER  = "X-Vcap-Request-Id"
+
+	TOKEN_REFRESH_MARGIN = 60 * time.Second
+)
 
 type errorResponse struct {
 	Code        string
+	Name        string
 	Description string
 }
 
@@ -45,7 +54,7 @@ func (gateway *Gateway) SetTokenRefresher(auth tokenRefresher) {
 func (gateway Gateway) NewRequest(method, path, accessToken string, body io.Reader) (req *Request, apiResponse ApiResponse) {
 	request, err := http.NewRequest(method, path, body)
 	if err != nil {
-		apiResponse = NewApiResponseWithError("Error building request", err)
+		apiResponse = NewApiResponseWithError(i18n.T("gateway.error_building_request"), err)
 		return
 	}
 
@@ -73,7 +82,7 @@ func (gateway Gateway) PerformRequestForResponseBytes(request *Request) (bytes [
 
 	bytes, err := ioutil.ReadAll(rawResponse.Body)
 	if err != nil {
-		apiResponse = NewApiResponseWithError("Error reading response", err)
+		apiResponse = NewApiResponseWithError(i18n.T("gateway.error_reading_response"), err)
 	}
 
 	headers = rawResponse.Header
@@ -94,7 +103,7 @@ func (gateway Gateway) PerformRequestForJSONResponse(request *Request, response
 
 	err := json.Unmarshal(bytes, &response)
 	if err != nil {
-		apiResponse = NewApiResponseWithError("Invalid JSON response from server", err)
+		apiResponse = NewApiResponseWithError(i18n.T("gateway.invalid_json_response"), err)
 	}
 	return
 }
@@ -107,6 +116,16 @@ func (gateway Gateway) doRequestHandlingAuth(request *Request) (rawResponse *htt
 		request.Body = ioutil.NopCloser(bytes.NewReader(bodyBytes))
 	}
 
+	// refresh the auth token ahead of time when it is about to expire
+	if gateway.authenticator != nil && tokenIsExpiring(request.Header.Get("Authorization")) {
+		var newToken string
+		newToken, apiResponse = gateway.authenticator.RefreshAuthToken()
+		if apiResponse.IsNotSuccessful() {
+			return
+		}
+		request.Header.Set("Authorization", newToken)
+	}
+
 	// perform request
 	rawResponse, apiResponse = gateway.doRequestAndHandlerError(request)
 	if apiResponse.IsSuccessful() || gateway.authenticator == nil {
@@ -134,22 +153,41 @@ func (gateway Gateway) doRequestHandlingAuth(request *Request) (rawResponse *htt
 	return
 }
 
+func tokenIsExpiring(accessToken string) bool {
+	expiresAt, err := configuration.TokenExpiresAt(accessToken)
+	if err != nil {
+		return false
+	}
+	return time.Now().Add(TOKEN_REFRESH_MARGIN).After(expiresAt)
+}
+
 func (gateway Gateway) doRequestAndHandlerError(request *Request) (rawResponse *http.Response, apiResponse ApiResponse) {
 	rawResponse, err := doRequest(request.Request)
 	if err != nil {
-		apiResponse = NewApiResponseWithError("Error performing request", err)
+		apiResponse = NewApiResponseWithError(i18n.T("gateway.error_performing_request"), err)
 		return
 	}
 
 	if rawResponse.StatusCode > 299 {
 		errorResponse := gateway.errHandler(rawResponse)
+		requestId := rawResponse.Header.Get(REQUEST_ID_HEADER)
+
 		message := fmt.Sprintf(
 			"Server error, status code: %d, error code: %s, message: %s",
 			rawResponse.StatusCode,
 			errorResponse.Code,
 			errorResponse.Description,
 		)
+		if errorResponse.Name != "" {
+			message += fmt.Sprintf("\nError name: %s", errorResponse.Name)
+		}
+		if requestId != "" {
+			message += fmt.Sprintf("\nRequest ID: %s", requestId)
+		}
+
 		apiResponse = NewApiResponse(message, errorResponse.Code, rawResponse.StatusCode)
+		apiResponse.ErrorName = errorResponse.Name
+		apiResponse.RequestId = requestId
 	}
 	return
 }
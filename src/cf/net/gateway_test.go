@@ -5,6 +5,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	. "cf/net"
+	"encoding/base64"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"io/ioutil"
@@ -15,6 +16,7 @@ import (
 	testapi "testhelpers/api"
 	testconfig "testhelpers/configuration"
 	"testing"
+	"time"
 )
 
 func TestNewRequest(t *testing.T) {
@@ -73,6 +75,70 @@ func TestRefreshingTheTokenWithCloudControllerRequestAndReturningError(t *testin
 	testRefreshTokenWithError(t, gateway, endpoint)
 }
 
+func TestRefreshingTheTokenWhenRefreshTokenIsRejected(t *testing.T) {
+	gateway := NewCloudControllerGateway()
+	endpoint := refreshTokenApiEndPoint(
+		`{ "code": 1000, "description": "Auth token is invalid" }`,
+		testapi.TestResponse{Status: http.StatusOK},
+	)
+	authEndpoint := func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprintln(writer, `{ "error": "invalid_token", "error_description": "Invalid refresh token" }`)
+	}
+
+	apiResponse := testRefreshTokenWithAuthEndpoint(t, gateway, endpoint, authEndpoint, "bearer initial-access-token")
+
+	assert.True(t, apiResponse.IsNotSuccessful())
+	assert.True(t, apiResponse.IsSessionExpired())
+	assert.Contains(t, apiResponse.Message, "Your session has expired.")
+}
+
+func TestRefreshingTheTokenBeforeItExpires(t *testing.T) {
+	requestCount := 0
+	endpoint := func(writer http.ResponseWriter, request *http.Request) {
+		requestCount++
+		if request.Header.Get("Authorization") != "bearer new-access-token" {
+			writer.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprintln(writer, `{ "code": 1000, "description": "Auth token is invalid" }`)
+		}
+	}
+	authEndpoint := func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprintln(
+			writer,
+			`{ "access_token": "new-access-token", "token_type": "bearer", "refresh_token": "new-refresh-token"}`,
+		)
+	}
+
+	expiringToken := accessTokenExpiringAt(time.Now().Add(10 * time.Second))
+	apiResponse := testRefreshTokenWithAuthEndpoint(t, NewCloudControllerGateway(), endpoint, authEndpoint, expiringToken)
+
+	assert.True(t, apiResponse.IsSuccessful())
+	assert.Equal(t, requestCount, 1)
+	assert.Equal(t, testconfig.SavedConfiguration.AccessToken, "bearer new-access-token")
+}
+
+func TestNotRefreshingTheTokenWhenItIsNotAboutToExpire(t *testing.T) {
+	validToken := accessTokenExpiringAt(time.Now().Add(time.Hour))
+	endpoint := func(writer http.ResponseWriter, request *http.Request) {
+		if request.Header.Get("Authorization") != validToken {
+			writer.WriteHeader(http.StatusUnauthorized)
+		}
+	}
+	authEndpoint := func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusInternalServerError)
+	}
+
+	apiResponse := testRefreshTokenWithAuthEndpoint(t, NewCloudControllerGateway(), endpoint, authEndpoint, validToken)
+
+	assert.True(t, apiResponse.IsSuccessful())
+}
+
+func accessTokenExpiringAt(expiresAt time.Time) string {
+	info := fmt.Sprintf(`{"user_name":"user1@example.com","exp":%d}`, expiresAt.Unix())
+	encodedInfo := base64.URLEncoding.EncodeToString([]byte(info))
+	return "bearer eyJhbGciOiJSUzI1NiJ9." + encodedInfo + ".signature"
+}
+
 func testRefreshTokenWithSuccess(t *testing.T, gateway Gateway, endpoint http.HandlerFunc) {
 	apiResponse := testRefreshToken(t, gateway, endpoint)
 	assert.True(t, apiResponse.IsSuccessful())
@@ -121,13 +187,17 @@ func testRefreshToken(t *testing.T, gateway Gateway, endpoint http.HandlerFunc)
 		)
 	}
 
+	return testRefreshTokenWithAuthEndpoint(t, gateway, endpoint, authEndpoint, "bearer initial-access-token")
+}
+
+func testRefreshTokenWithAuthEndpoint(t *testing.T, gateway Gateway, endpoint http.HandlerFunc, authEndpoint http.HandlerFunc, accessToken string) (apiResponse ApiResponse) {
 	apiServer := httptest.NewTLSServer(endpoint)
 	defer apiServer.Close()
 
 	authServer := httptest.NewTLSServer(http.HandlerFunc(authEndpoint))
 	defer authServer.Close()
 
-	config, auth := createAuthenticationRepository(t, apiServer, authServer)
+	config, auth := createAuthenticationRepository(t, apiServer, authServer, accessToken)
 	gateway.SetTokenRefresher(auth)
 
 	request, apiResponse := gateway.NewRequest("POST", config.Target+"/v2/foo", config.AccessToken, strings.NewReader("expected body"))
@@ -137,7 +207,7 @@ func testRefreshToken(t *testing.T, gateway Gateway, endpoint http.HandlerFunc)
 	return
 }
 
-func createAuthenticationRepository(t *testing.T, apiServer *httptest.Server, authServer *httptest.Server) (*configuration.Configuration, api.AuthenticationRepository) {
+func createAuthenticationRepository(t *testing.T, apiServer *httptest.Server, authServer *httptest.Server, accessToken string) (*configuration.Configuration, api.AuthenticationRepository) {
 	configRepo := testconfig.FakeConfigRepository{}
 	configRepo.Delete()
 	config, err := configRepo.Get()
@@ -145,7 +215,7 @@ func createAuthenticationRepository(t *testing.T, apiServer *httptest.Server, au
 
 	config.AuthorizationEndpoint = authServer.URL
 	config.Target = apiServer.URL
-	config.AccessToken = "bearer initial-access-token"
+	config.AccessToken = accessToken
 	config.RefreshToken = "initial-refresh-token"
 
 	authGateway := NewUAAGateway()
@@ -3,17 +3,22 @@ package net
 import (
 	"cf/terminal"
 	"crypto/tls"
-	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httputil"
+	"net/url"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 const (
 	PRIVATE_DATA_PLACEHOLDER = "[PRIVATE DATA HIDDEN]"
+	DEFAULT_MAX_REDIRECTS    = 10
 )
 
 func newHttpClient() *http.Client {
@@ -28,35 +33,68 @@ func newHttpClient() *http.Client {
 }
 
 func PrepareRedirect(req *http.Request, via []*http.Request) error {
-	if len(via) > 1 {
-		return errors.New("stopped after 1 redirect")
+	maxRedirects := maxRedirects()
+	if len(via) > maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
 	}
 
-	prevReq := via[len(via)-1]
+	originalReq := via[0]
 
-	req.Header.Set("Authorization", prevReq.Header.Get("Authorization"))
+	if sameOrigin(req.URL, originalReq.URL) {
+		req.Header.Set("Authorization", originalReq.Header.Get("Authorization"))
+	} else {
+		req.Header.Del("Authorization")
+	}
 
-	if traceEnabled() {
-		dumpRequest(req)
+	if trace := newTracer(); trace != nil {
+		trace.dumpRequest(req)
 	}
 
 	return nil
 }
 
+func maxRedirects() int {
+	maxRedirects, err := strconv.Atoi(os.Getenv("CF_MAX_REDIRECTS"))
+	if err != nil || maxRedirects < 0 {
+		return DEFAULT_MAX_REDIRECTS
+	}
+	return maxRedirects
+}
+
+// The bearer token is only forwarded to the host it was meant for, and never
+// over http when it was first sent over https.
+func sameOrigin(redirectUrl, originalUrl *url.URL) bool {
+	return strings.EqualFold(redirectUrl.Scheme, originalUrl.Scheme) &&
+		strings.EqualFold(redirectUrl.Host, originalUrl.Host)
+}
+
+// Any name ending in password, whatever its case
+const passwordName = `(?i:[a-z_]*password)`
+
 func Sanitize(input string) (sanitized string) {
+	// Names are patterns, so that every kind of password is hidden, such as
+	// the oldPassword sent when changing it
 	var sanitizeJson = func(propertyName string, json string) string {
-		re := regexp.MustCompile(fmt.Sprintf(`"%s":"[^"]*"`, propertyName))
-		return re.ReplaceAllString(json, fmt.Sprintf(`"%s":"`+PRIVATE_DATA_PLACEHOLDER+`"`, propertyName))
+		re := regexp.MustCompile(fmt.Sprintf(`"(%s)":(\s*)"[^"]*"`, propertyName))
+		return re.ReplaceAllString(json, `"${1}":${2}"`+PRIVATE_DATA_PLACEHOLDER+`"`)
+	}
+
+	var sanitizeForm = func(paramName string, form string) string {
+		re := regexp.MustCompile(fmt.Sprintf(`(^|[&\s])(%s)=[^&\s]*`, paramName))
+		return re.ReplaceAllString(form, "${1}${2}="+PRIVATE_DATA_PLACEHOLDER)
 	}
 
 	re := regexp.MustCompile(`(?m)^Authorization: .*`)
 	sanitized = re.ReplaceAllString(input, "Authorization: "+PRIVATE_DATA_PLACEHOLDER)
-	re = regexp.MustCompile(`password=[^&]*&`)
-	sanitized = re.ReplaceAllString(sanitized, "password="+PRIVATE_DATA_PLACEHOLDER+"&")
+
+	sanitized = sanitizeForm(passwordName, sanitized)
+	sanitized = sanitizeForm("client_secret", sanitized)
 
 	sanitized = sanitizeJson("access_token", sanitized)
 	sanitized = sanitizeJson("refresh_token", sanitized)
 	sanitized = sanitizeJson("token", sanitized)
+	sanitized = sanitizeJson(passwordName, sanitized)
+	sanitized = sanitizeJson("client_secret", sanitized)
 
 	return
 }
@@ -64,42 +102,110 @@ func Sanitize(input string) (sanitized string) {
 func doRequest(request *http.Request) (response *http.Response, err error) {
 	httpClient := newHttpClient()
 
-	if traceEnabled() {
-		dumpRequest(request)
+	trace := newTracer()
+	if trace != nil {
+		trace.dumpRequest(request)
 	}
 
+	startedAt := time.Now()
 	response, err = httpClient.Do(request)
 
 	if err != nil {
 		return
 	}
 
-	if traceEnabled() {
-		dumpedResponse, err := httputil.DumpResponse(response, true)
+	if trace != nil {
+		trace.dumpResponse(response, time.Since(startedAt))
+	}
+
+	return
+}
+
+type tracer struct {
+	writer  io.Writer
+	colored bool
+}
+
+var (
+	traceLock   sync.Mutex
+	traceTarget string
+	traceFile   *os.File
+)
+
+// newTracer returns nil when CF_TRACE is not set. CF_TRACE=true or CF_TRACE=yes
+// traces to stderr, so the output of a command can still be piped. Any other
+// value is the path of a file to append traces to. The file is opened once and
+// kept open for the other requests of the command; when it cannot be opened,
+// that is reported once and the traces go to stderr instead.
+func newTracer() *tracer {
+	traceEnv := os.Getenv("CF_TRACE")
+
+	switch strings.ToLower(traceEnv) {
+	case "", "false", "no":
+		return nil
+	case "true", "yes":
+		return &tracer{writer: os.Stderr, colored: true}
+	}
+
+	traceLock.Lock()
+	defer traceLock.Unlock()
+
+	if traceTarget != traceEnv {
+		if traceFile != nil {
+			traceFile.Close()
+		}
+		traceTarget = traceEnv
+
+		var err error
+		traceFile, err = os.OpenFile(traceEnv, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
 		if err != nil {
-			fmt.Println("Error dumping response")
-		} else {
-			fmt.Printf("\n%s\n%s\n", terminal.HeaderColor("RESPONSE:"), Sanitize(string(dumpedResponse)))
+			traceFile = nil
+			fmt.Fprintf(os.Stderr, "Error opening trace file %s: %s\n", traceEnv, err.Error())
 		}
 	}
 
-	return
+	if traceFile == nil {
+		return &tracer{writer: os.Stderr, colored: true}
+	}
+	return &tracer{writer: traceFile}
 }
 
-func traceEnabled() bool {
-	traceEnv := strings.ToLower(os.Getenv("CF_TRACE"))
-	return traceEnv == "true" || traceEnv == "yes"
+func (trace *tracer) header(title string) string {
+	if trace.colored {
+		return terminal.HeaderColor(title)
+	}
+	return title
 }
 
-func dumpRequest(req *http.Request) {
+func (trace *tracer) dumpRequest(req *http.Request) {
 	shouldDisplayBody := !strings.Contains(req.Header.Get("Content-Type"), "multipart/form-data")
 	dumpedRequest, err := httputil.DumpRequest(req, shouldDisplayBody)
 	if err != nil {
-		fmt.Println("Error dumping request")
-	} else {
-		fmt.Printf("\n%s\n%s\n", terminal.HeaderColor("REQUEST:"), Sanitize(string(dumpedRequest)))
-		if !shouldDisplayBody {
-			fmt.Println("[MULTIPART/FORM-DATA CONTENT HIDDEN]")
-		}
+		fmt.Fprintln(trace.writer, "Error dumping request")
+		return
+	}
+
+	fmt.Fprintf(trace.writer, "\n%s [%s]\n%s\n",
+		trace.header("REQUEST:"),
+		time.Now().Format(time.RFC3339),
+		Sanitize(string(dumpedRequest)),
+	)
+	if !shouldDisplayBody {
+		fmt.Fprintln(trace.writer, "[MULTIPART/FORM-DATA CONTENT HIDDEN]")
 	}
 }
+
+func (trace *tracer) dumpResponse(response *http.Response, elapsed time.Duration) {
+	dumpedResponse, err := httputil.DumpResponse(response, true)
+	if err != nil {
+		fmt.Fprintln(trace.writer, "Error dumping response")
+		return
+	}
+
+	fmt.Fprintf(trace.writer, "\n%s [%s] (elapsed: %s)\n%s\n",
+		trace.header("RESPONSE:"),
+		time.Now().Format(time.RFC3339),
+		elapsed,
+		Sanitize(string(dumpedResponse)),
+	)
+}
@@ -1,9 +1,17 @@
 package net_test
 
 import (
+	"bytes"
 	. "cf/net"
+	"fmt"
 	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -52,6 +60,44 @@ grant_type=password&password=[PRIVATE DATA HIDDEN]&scope=&username=mgehard%2Bcli
 	assert.Equal(t, Sanitize(request), expected)
 }
 
+func TestSanitizeRemovesPasswordsAndClientSecretFromJsonBody(t *testing.T) {
+	request := `
+PUT /Users/my-user-guid/password HTTP/1.1
+Host: uaa.run.pivotal.io
+Content-Type: application/json
+
+{"password":"new-password","oldPassword":"old-password","client_secret": "my-secret"}
+`
+
+	expected := `
+PUT /Users/my-user-guid/password HTTP/1.1
+Host: uaa.run.pivotal.io
+Content-Type: application/json
+
+{"password":"[PRIVATE DATA HIDDEN]","oldPassword":"[PRIVATE DATA HIDDEN]","client_secret": "[PRIVATE DATA HIDDEN]"}
+`
+	assert.Equal(t, Sanitize(request), expected)
+}
+
+func TestSanitizeRemovesClientSecretFromForm(t *testing.T) {
+	request := `
+POST /oauth/token HTTP/1.1
+Host: login.run.pivotal.io
+Content-Type: application/x-www-form-urlencoded
+
+grant_type=client_credentials&client_id=my-client&client_secret=my-secret
+`
+
+	expected := `
+POST /oauth/token HTTP/1.1
+Host: login.run.pivotal.io
+Content-Type: application/x-www-form-urlencoded
+
+grant_type=client_credentials&client_id=my-client&client_secret=[PRIVATE DATA HIDDEN]
+`
+	assert.Equal(t, Sanitize(request), expected)
+}
+
 func TestSanitizeRemovesOauthTokensFromBody(t *testing.T) {
 	response := `
 HTTP/1.1 200 OK
@@ -144,7 +190,64 @@ func TestPrepareRedirectTransfersAuthorizationHeader(t *testing.T) {
 	assert.Equal(t, redirectReq.Header.Get("Authorization"), "my-auth-token")
 }
 
-func TestPrepareRedirectFailsAfterOneRedirect(t *testing.T) {
+func TestPrepareRedirectTransfersAuthorizationHeaderToTheSameHost(t *testing.T) {
+	originalReq, err := http.NewRequest("GET", "https://api.example.com/foo", nil)
+	assert.NoError(t, err)
+	originalReq.Header.Set("Authorization", "my-auth-token")
+
+	firstRedirectReq, err := http.NewRequest("GET", "https://API.example.com/bar", nil)
+	assert.NoError(t, err)
+
+	redirectReq, err := http.NewRequest("GET", "https://api.example.com/baz", nil)
+	assert.NoError(t, err)
+
+	via := []*http.Request{originalReq, firstRedirectReq}
+
+	err = PrepareRedirect(redirectReq, via)
+
+	assert.NoError(t, err)
+	assert.Equal(t, redirectReq.Header.Get("Authorization"), "my-auth-token")
+}
+
+func TestPrepareRedirectDoesNotTransferAuthorizationHeaderToAnotherHost(t *testing.T) {
+	originalReq, err := http.NewRequest("GET", "https://api.example.com/foo", nil)
+	assert.NoError(t, err)
+	originalReq.Header.Set("Authorization", "my-auth-token")
+
+	redirectReq, err := http.NewRequest("GET", "https://blobstore.example.net/bar", nil)
+	assert.NoError(t, err)
+	redirectReq.Header.Set("Authorization", "my-auth-token")
+
+	via := []*http.Request{originalReq}
+
+	err = PrepareRedirect(redirectReq, via)
+
+	assert.NoError(t, err)
+	assert.Equal(t, redirectReq.Header.Get("Authorization"), "")
+}
+
+func TestPrepareRedirectDoesNotTransferAuthorizationHeaderOverPlainHttp(t *testing.T) {
+	originalReq, err := http.NewRequest("GET", "https://api.example.com/foo", nil)
+	assert.NoError(t, err)
+	originalReq.Header.Set("Authorization", "my-auth-token")
+
+	redirectReq, err := http.NewRequest("GET", "http://api.example.com/bar", nil)
+	assert.NoError(t, err)
+	redirectReq.Header.Set("Authorization", "my-auth-token")
+
+	via := []*http.Request{originalReq}
+
+	err = PrepareRedirect(redirectReq, via)
+
+	assert.NoError(t, err)
+	assert.Equal(t, redirectReq.Header.Get("Authorization"), "")
+}
+
+func TestPrepareRedirectFailsAfterMaxRedirects(t *testing.T) {
+	oldMaxRedirects := os.Getenv("CF_MAX_REDIRECTS")
+	os.Setenv("CF_MAX_REDIRECTS", "1")
+	defer os.Setenv("CF_MAX_REDIRECTS", oldMaxRedirects)
+
 	firstReq, err := http.NewRequest("GET", "/foo", nil)
 	assert.NoError(t, err)
 
@@ -154,9 +257,140 @@ func TestPrepareRedirectFailsAfterOneRedirect(t *testing.T) {
 	redirectReq, err := http.NewRequest("GET", "/bar", nil)
 	assert.NoError(t, err)
 
-	via := []*http.Request{firstReq, secondReq}
+	err = PrepareRedirect(redirectReq, []*http.Request{firstReq})
+	assert.NoError(t, err)
+
+	err = PrepareRedirect(redirectReq, []*http.Request{firstReq, secondReq})
+	assert.Error(t, err)
+}
+
+func TestPrepareRedirectAllowsSeveralRedirectsByDefault(t *testing.T) {
+	via := []*http.Request{}
+	for i := 0; i < DEFAULT_MAX_REDIRECTS; i++ {
+		req, err := http.NewRequest("GET", fmt.Sprintf("/redirect/%d", i), nil)
+		assert.NoError(t, err)
+		via = append(via, req)
+	}
+
+	redirectReq, err := http.NewRequest("GET", "/bar", nil)
+	assert.NoError(t, err)
 
 	err = PrepareRedirect(redirectReq, via)
+	assert.NoError(t, err)
 
+	err = PrepareRedirect(redirectReq, append(via, redirectReq))
 	assert.Error(t, err)
 }
+
+func TestTraceToFileAppendsRequestsAndResponses(t *testing.T) {
+	traceFile, err := ioutil.TempFile("", "cf-trace")
+	assert.NoError(t, err)
+	traceFile.WriteString("existing trace\n")
+	traceFile.Close()
+	defer os.Remove(traceFile.Name())
+
+	oldTrace := os.Getenv("CF_TRACE")
+	os.Setenv("CF_TRACE", traceFile.Name())
+	defer os.Setenv("CF_TRACE", oldTrace)
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprintln(writer, `{"access_token":"my-access-token"}`)
+	}))
+	defer ts.Close()
+
+	gateway := NewUAAGateway()
+	request, apiResponse := gateway.NewRequest("POST", ts.URL+"/oauth/token", "BEARER my-token", strings.NewReader(`{"password":"my-password"}`))
+	assert.True(t, apiResponse.IsSuccessful())
+
+	apiResponse = gateway.PerformRequest(request)
+	assert.True(t, apiResponse.IsSuccessful())
+
+	traceBytes, err := ioutil.ReadFile(traceFile.Name())
+	assert.NoError(t, err)
+	trace := string(traceBytes)
+
+	assert.True(t, strings.HasPrefix(trace, "existing trace\n"))
+	assert.Contains(t, trace, "REQUEST: [")
+	assert.Contains(t, trace, "RESPONSE: [")
+	assert.Contains(t, trace, "(elapsed: ")
+	assert.Contains(t, trace, "POST /oauth/token")
+	assert.Contains(t, trace, `"access_token":"[PRIVATE DATA HIDDEN]"`)
+	assert.NotContains(t, trace, "my-password")
+	assert.NotContains(t, trace, "my-token")
+}
+
+func TestTraceTrueWritesToStderr(t *testing.T) {
+	oldTrace := os.Getenv("CF_TRACE")
+	os.Setenv("CF_TRACE", "true")
+	defer os.Setenv("CF_TRACE", oldTrace)
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprintln(writer, `{}`)
+	}))
+	defer ts.Close()
+
+	var stdout string
+	stderr := captureStream(&os.Stderr, func() {
+		stdout = captureStream(&os.Stdout, func() {
+			performTracedRequest(t, ts.URL)
+		})
+	})
+
+	assert.Equal(t, stdout, "")
+	assert.Contains(t, stderr, "GET /v2/info")
+}
+
+func TestTraceFileThatCannotBeOpenedIsReportedOnce(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cf-trace")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	oldTrace := os.Getenv("CF_TRACE")
+	os.Setenv("CF_TRACE", filepath.Join(dir, "missing", "trace.log"))
+	defer os.Setenv("CF_TRACE", oldTrace)
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprintln(writer, `{}`)
+	}))
+	defer ts.Close()
+
+	var stdout string
+	stderr := captureStream(&os.Stderr, func() {
+		stdout = captureStream(&os.Stdout, func() {
+			performTracedRequest(t, ts.URL)
+			performTracedRequest(t, ts.URL)
+		})
+	})
+
+	assert.Equal(t, stdout, "")
+	assert.Equal(t, strings.Count(stderr, "Error opening trace file"), 1)
+	assert.Equal(t, strings.Count(stderr, "GET /v2/info"), 2)
+}
+
+func performTracedRequest(t *testing.T, target string) {
+	gateway := NewCloudControllerGateway()
+	request, apiResponse := gateway.NewRequest("GET", target+"/v2/info", "BEARER my-token", nil)
+	assert.True(t, apiResponse.IsSuccessful())
+
+	apiResponse = gateway.PerformRequest(request)
+	assert.True(t, apiResponse.IsSuccessful())
+}
+
+func captureStream(stream **os.File, f func()) string {
+	old := *stream
+	r, w, _ := os.Pipe()
+	*stream = w
+
+	outC := make(chan string)
+	go func() {
+		var buf bytes.Buffer
+		io.Copy(&buf, r)
+		outC <- buf.String()
+	}()
+
+	f()
+
+	w.Close()
+	*stream = old
+	return <-outC
+}
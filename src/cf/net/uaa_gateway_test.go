@@ -10,6 +10,7 @@ import (
 )
 
 var failingUAARequest = func(writer http.ResponseWriter, request *http.Request) {
+	writer.Header().Set("X-Vcap-Request-Id", "my-request-id")
 	writer.WriteHeader(http.StatusBadRequest)
 	jsonResponse := `{ "error": "foo", "error_description": "The foo is wrong..." }`
 	fmt.Fprintln(writer, jsonResponse)
@@ -29,4 +30,7 @@ func TestUAAGatewayErrorHandling(t *testing.T) {
 	assert.True(t, apiResponse.IsNotSuccessful())
 	assert.Contains(t, apiResponse.Message, "The foo is wrong")
 	assert.Contains(t, apiResponse.ErrorCode, "foo")
+	assert.Equal(t, apiResponse.RequestId, "my-request-id")
+	assert.Contains(t, apiResponse.Message, "Request ID: my-request-id")
+	assert.NotContains(t, apiResponse.Message, "Error name:")
 }
@@ -0,0 +1,123 @@
+package net
+
+import (
+	"bufio"
+	"code.google.com/p/go.net/websocket"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+func DialWebsocket(config *websocket.Config) (ws *websocket.Conn, err error) {
+	proxyUrl, err := websocketProxyUrl(config.Location)
+	if err != nil {
+		return
+	}
+
+	if proxyUrl == nil {
+		return websocket.DialConfig(config)
+	}
+
+	conn, err := dialThroughProxy(proxyUrl, hostWithPort(config.Location))
+	if err != nil {
+		return
+	}
+
+	if config.Location.Scheme == "wss" {
+		tlsConn := tls.Client(conn, websocketTlsConfig(config))
+		err = tlsConn.Handshake()
+		if err != nil {
+			conn.Close()
+			return
+		}
+		conn = tlsConn
+	}
+
+	ws, err = websocket.NewClient(config, conn)
+	if err != nil {
+		conn.Close()
+	}
+	return
+}
+
+// Websockets go through the same proxy as the API requests, so the proxy is
+// looked up for the http or https URL of the websocket.
+func websocketProxyUrl(location *url.URL) (proxyUrl *url.URL, err error) {
+	httpLocation := *location
+	httpLocation.Scheme = "http"
+	if location.Scheme == "wss" {
+		httpLocation.Scheme = "https"
+	}
+
+	return http.ProxyFromEnvironment(&http.Request{URL: &httpLocation})
+}
+
+func dialThroughProxy(proxyUrl *url.URL, addr string) (conn net.Conn, err error) {
+	conn, err = net.Dial("tcp", hostWithPort(proxyUrl))
+	if err != nil {
+		err = fmt.Errorf("Error connecting to proxy %s: %s", proxyUrl.Host, err.Error())
+		return
+	}
+
+	connectReq := &http.Request{
+		Method: "CONNECT",
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+
+	if proxyUrl.User != nil {
+		password, _ := proxyUrl.User.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(proxyUrl.User.Username() + ":" + password))
+		connectReq.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	err = connectReq.Write(conn)
+	if err != nil {
+		conn.Close()
+		return
+	}
+
+	response, err := http.ReadResponse(bufio.NewReader(conn), connectReq)
+	if err != nil {
+		conn.Close()
+		return
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		conn.Close()
+		err = fmt.Errorf("Error connecting to %s through proxy %s: %s", addr, proxyUrl.Host, response.Status)
+	}
+	return
+}
+
+func websocketTlsConfig(config *websocket.Config) (tlsConfig *tls.Config) {
+	tlsConfig = &tls.Config{}
+	if config.TlsConfig != nil {
+		tlsConfig.ServerName = config.TlsConfig.ServerName
+		tlsConfig.RootCAs = config.TlsConfig.RootCAs
+		tlsConfig.Certificates = config.TlsConfig.Certificates
+		tlsConfig.InsecureSkipVerify = config.TlsConfig.InsecureSkipVerify
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName, _, _ = net.SplitHostPort(hostWithPort(config.Location))
+	}
+	return
+}
+
+func hostWithPort(location *url.URL) string {
+	if _, _, err := net.SplitHostPort(location.Host); err == nil {
+		return location.Host
+	}
+
+	port := "80"
+	switch location.Scheme {
+	case "wss", "https":
+		port = "443"
+	}
+	return net.JoinHostPort(location.Host, port)
+}
@@ -0,0 +1,124 @@
+package net_test
+
+import (
+	. "cf/net"
+	"code.google.com/p/go.net/websocket"
+	"crypto/tls"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+type fakeConnectProxy struct {
+	targets      map[string]string
+	connectedTo  []string
+	proxyAuthHdr string
+}
+
+func (proxy *fakeConnectProxy) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != "CONNECT" {
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	proxy.connectedTo = append(proxy.connectedTo, request.Host)
+	proxy.proxyAuthHdr = request.Header.Get("Proxy-Authorization")
+
+	target, err := net.Dial("tcp", proxy.targets[request.Host])
+	if err != nil {
+		writer.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	clientConn, _, err := writer.(http.Hijacker).Hijack()
+	if err != nil {
+		target.Close()
+		return
+	}
+
+	io.WriteString(clientConn, "HTTP/1.1 200 Connection established\r\n\r\n")
+
+	go func() {
+		io.Copy(target, clientConn)
+		target.Close()
+	}()
+	io.Copy(clientConn, target)
+	clientConn.Close()
+}
+
+func echoWebsocketServer() *httptest.Server {
+	return httptest.NewTLSServer(websocket.Handler(func(conn *websocket.Conn) {
+		io.Copy(conn, conn)
+	}))
+}
+
+// The proxy settings are read from the environment once per process by some
+// versions of net/http, so all the tests share one proxy.
+var sharedProxy = &fakeConnectProxy{}
+
+func init() {
+	proxyServer := httptest.NewServer(sharedProxy)
+	proxyUrl := strings.Replace(proxyServer.URL, "http://", "http://user:pass@", 1)
+
+	os.Setenv("HTTP_PROXY", proxyUrl)
+	os.Setenv("HTTPS_PROXY", proxyUrl)
+	os.Setenv("NO_PROXY", "localhost, .noproxy.invalid")
+}
+
+func dialThroughFakeProxy(t *testing.T, location string) (proxy *fakeConnectProxy, err error) {
+	wsServer := echoWebsocketServer()
+	defer wsServer.Close()
+
+	proxy = sharedProxy
+	proxy.connectedTo = []string{}
+	proxy.targets = map[string]string{
+		"loggregator.example.invalid:443": strings.TrimPrefix(wsServer.URL, "https://"),
+	}
+
+	config, err := websocket.NewConfig(location, "http://localhost")
+	assert.NoError(t, err)
+	config.TlsConfig = &tls.Config{InsecureSkipVerify: true}
+
+	ws, err := DialWebsocket(config)
+	if err != nil {
+		return
+	}
+	defer ws.Close()
+
+	err = websocket.Message.Send(ws, "hello")
+	assert.NoError(t, err)
+
+	var reply string
+	err = websocket.Message.Receive(ws, &reply)
+	assert.NoError(t, err)
+	assert.Equal(t, reply, "hello")
+	return
+}
+
+func TestDialWebsocketTunnelsThroughProxy(t *testing.T) {
+	proxy, err := dialThroughFakeProxy(t, "wss://loggregator.example.invalid/tail/?app=my-app-guid")
+
+	assert.NoError(t, err)
+	assert.Equal(t, proxy.connectedTo, []string{"loggregator.example.invalid:443"})
+	assert.Equal(t, proxy.proxyAuthHdr, "Basic dXNlcjpwYXNz")
+}
+
+func TestDialWebsocketSkipsProxyForNoProxyHosts(t *testing.T) {
+	proxy, err := dialThroughFakeProxy(t, "wss://loggregator.noproxy.invalid/tail/?app=my-app-guid")
+
+	assert.Error(t, err)
+	assert.Empty(t, proxy.connectedTo)
+}
+
+func TestDialWebsocketReportsProxyFailures(t *testing.T) {
+	proxy, err := dialThroughFakeProxy(t, "wss://unreachable.example.invalid/tail/")
+
+	assert.Error(t, err)
+	assert.Equal(t, proxy.connectedTo, []string{"unreachable.example.invalid:443"})
+	assert.Contains(t, err.Error(), "502")
+}
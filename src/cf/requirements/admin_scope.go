@@ -0,0 +1,30 @@
+package requirements
+
+import (
+	"cf/configuration"
+	"cf/i18n"
+	"cf/terminal"
+)
+
+// Admin commands warn when the access token lacks the admin scope, but still
+// run, as the server decides what the user may do.
+type AdminScopeRequirement struct {
+	ui     terminal.UI
+	config *configuration.Configuration
+}
+
+func newAdminScopeRequirement(ui terminal.UI, config *configuration.Configuration) AdminScopeRequirement {
+	return AdminScopeRequirement{ui, config}
+}
+
+func (req AdminScopeRequirement) Execute() (err error) {
+	info, err := req.config.TokenInfo()
+	if err != nil {
+		return nil
+	}
+
+	if !info.HasScope(configuration.AdminScope) {
+		req.ui.Warn(i18n.T("scopes.missing_scope"), configuration.AdminScope, i18n.T("scopes.admin_purpose"))
+	}
+	return
+}
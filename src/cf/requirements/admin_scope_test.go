@@ -0,0 +1,35 @@
+package requirements
+
+import (
+	"cf/configuration"
+	"encoding/base64"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	testterm "testhelpers/terminal"
+	"testing"
+)
+
+func TestAdminScopeRequirementWarnsWithoutTheAdminScope(t *testing.T) {
+	ui := new(testterm.FakeUI)
+	config := &configuration.Configuration{AccessToken: tokenWithScopes(`["cloud_controller.read","cloud_controller.write"]`)}
+
+	err := newAdminScopeRequirement(ui, config).Execute()
+
+	assert.NoError(t, err)
+	assert.Contains(t, ui.Outputs[0], "Missing scope cloud_controller.admin, which is needed for admin commands")
+}
+
+func TestAdminScopeRequirementWithTheAdminScope(t *testing.T) {
+	ui := new(testterm.FakeUI)
+	config := &configuration.Configuration{AccessToken: tokenWithScopes(`["cloud_controller.admin"]`)}
+
+	err := newAdminScopeRequirement(ui, config).Execute()
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(ui.Outputs), 0)
+}
+
+func tokenWithScopes(scopes string) string {
+	payload := base64.URLEncoding.EncodeToString([]byte(`{"user_name":"admin","scope":` + scopes + `}`))
+	return "bearer eyJhbGciOiJSUzI1NiJ9." + strings.TrimRight(payload, "=") + ".signature"
+}
@@ -16,27 +16,41 @@ type applicationApiRequirement struct {
 	name        string
 	ui          terminal.UI
 	appRepo     api.ApplicationRepository
+	spaceRepo   api.SpaceRepository
 	application cf.Application
 }
 
-func newApplicationRequirement(name string, ui terminal.UI, aR api.ApplicationRepository) (req *applicationApiRequirement) {
+func newApplicationRequirement(name string, ui terminal.UI, aR api.ApplicationRepository, sR api.SpaceRepository) (req *applicationApiRequirement) {
 	req = new(applicationApiRequirement)
 	req.name = name
 	req.ui = ui
 	req.appRepo = aR
+	req.spaceRepo = sR
 	return
 }
 
-func (req *applicationApiRequirement) Execute() (success bool) {
+func (req *applicationApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.application, apiResponse = req.appRepo.FindByName(req.name)
 
 	if apiResponse.IsNotSuccessful() {
-		req.ui.Failed(apiResponse.Message)
-		return false
+		req.ui.Failed(notFoundMessage(apiResponse, req.name, req.appNames))
+		return apiResponse.AsError()
 	}
 
-	return true
+	return
+}
+
+func (req *applicationApiRequirement) appNames() (names []string) {
+	space, apiResponse := req.spaceRepo.GetSummary()
+	if apiResponse.IsNotSuccessful() {
+		return
+	}
+
+	for _, app := range space.Applications {
+		names = append(names, app.Name)
+	}
+	return
 }
 
 func (req *applicationApiRequirement) GetApplication() cf.Application {
@@ -13,10 +13,10 @@ func TestApplicationReqExecute(t *testing.T) {
 	appRepo := &testapi.FakeApplicationRepository{FindByNameApp: app}
 	ui := new(testterm.FakeUI)
 
-	appReq := newApplicationRequirement("foo", ui, appRepo)
-	success := appReq.Execute()
+	appReq := newApplicationRequirement("foo", ui, appRepo, &testapi.FakeSpaceRepository{})
+	err := appReq.Execute()
 
-	assert.True(t, success)
+	assert.NoError(t, err)
 	assert.Equal(t, appRepo.FindByNameName, "foo")
 	assert.Equal(t, appReq.GetApplication(), app)
 }
@@ -25,8 +25,40 @@ func TestApplicationReqExecuteWhenApplicationNotFound(t *testing.T) {
 	appRepo := &testapi.FakeApplicationRepository{FindByNameNotFound: true}
 	ui := new(testterm.FakeUI)
 
-	appReq := newApplicationRequirement("foo", ui, appRepo)
-	success := appReq.Execute()
+	appReq := newApplicationRequirement("foo", ui, appRepo, &testapi.FakeSpaceRepository{})
+	err := appReq.Execute()
 
-	assert.False(t, success)
+	assert.Error(t, err)
+}
+
+func TestApplicationReqSuggestsAppsWithSimilarNames(t *testing.T) {
+	appRepo := &testapi.FakeApplicationRepository{FindByNameNotFound: true}
+	spaceRepo := &testapi.FakeSpaceRepository{
+		SummarySpace: cf.Space{Applications: []cf.Application{
+			cf.Application{Name: "my-app"},
+			cf.Application{Name: "my-api"},
+			cf.Application{Name: "database"},
+		}},
+	}
+	ui := new(testterm.FakeUI)
+
+	appReq := newApplicationRequirement("my-apq", ui, appRepo, spaceRepo)
+	err := appReq.Execute()
+
+	assert.Error(t, err)
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "App my-apq not found\nDid you mean my-api, my-app?")
+}
+
+func TestApplicationReqDoesNotSuggestUnrelatedApps(t *testing.T) {
+	appRepo := &testapi.FakeApplicationRepository{FindByNameNotFound: true}
+	spaceRepo := &testapi.FakeSpaceRepository{
+		SummarySpace: cf.Space{Applications: []cf.Application{cf.Application{Name: "database"}}},
+	}
+	ui := new(testterm.FakeUI)
+
+	appReq := newApplicationRequirement("my-app", ui, appRepo, spaceRepo)
+	appReq.Execute()
+
+	assert.Equal(t, ui.Outputs[1], "App my-app not found")
 }
@@ -27,16 +27,16 @@ func newDomainRequirement(name string, ui terminal.UI, domainRepo api.DomainRepo
 	return
 }
 
-func (req *domainApiRequirement) Execute() bool {
+func (req *domainApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.domain, apiResponse = req.domainRepo.FindByNameInCurrentSpace(req.name)
 
 	if apiResponse.IsNotSuccessful() {
 		req.ui.Failed(apiResponse.Message)
-		return false
+		return apiResponse.AsError()
 	}
 
-	return true
+	return
 }
 
 func (req *domainApiRequirement) GetDomain() cf.Domain {
@@ -7,13 +7,14 @@ import (
 )
 
 type Requirement interface {
-	Execute() (success bool)
+	Execute() (err error)
 }
 
 type Factory interface {
 	NewApplicationRequirement(name string) ApplicationRequirement
 	NewServiceInstanceRequirement(name string) ServiceInstanceRequirement
 	NewLoginRequirement() Requirement
+	NewAdminScopeRequirement() Requirement
 	NewValidAccessTokenRequirement() Requirement
 	NewSpaceRequirement(name string) SpaceRequirement
 	NewTargetedSpaceRequirement() Requirement
@@ -39,6 +40,7 @@ func (f apiRequirementFactory) NewApplicationRequirement(name string) Applicatio
 		name,
 		f.ui,
 		f.repoLocator.GetApplicationRepository(),
+		f.repoLocator.GetSpaceRepository(),
 	)
 }
 
@@ -47,6 +49,7 @@ func (f apiRequirementFactory) NewServiceInstanceRequirement(name string) Servic
 		name,
 		f.ui,
 		f.repoLocator.GetServiceRepository(),
+		f.repoLocator.GetSpaceRepository(),
 	)
 }
 
@@ -56,6 +59,14 @@ func (f apiRequirementFactory) NewLoginRequirement() Requirement {
 		f.config,
 	)
 }
+
+func (f apiRequirementFactory) NewAdminScopeRequirement() Requirement {
+	return newAdminScopeRequirement(
+		f.ui,
+		f.config,
+	)
+}
+
 func (f apiRequirementFactory) NewValidAccessTokenRequirement() Requirement {
 	return newValidAccessTokenRequirement(
 		f.ui,
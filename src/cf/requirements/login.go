@@ -1,6 +1,7 @@
 package requirements
 
 import (
+	"cf"
 	"cf/configuration"
 	"cf/terminal"
 )
@@ -14,10 +15,10 @@ func newLoginRequirement(ui terminal.UI, config *configuration.Configuration) Lo
 	return LoginRequirement{ui, config}
 }
 
-func (req LoginRequirement) Execute() (success bool) {
+func (req LoginRequirement) Execute() (err error) {
 	if !req.config.IsLoggedIn() {
-		req.ui.Say(terminal.NotLoggedInText())
-		return false
+		req.ui.Failed("%s", terminal.NotLoggedInText())
+		return cf.NewCommandError(cf.AuthError, "%s", terminal.NotLoggedInText())
 	}
-	return true
+	return
 }
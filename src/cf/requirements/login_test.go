@@ -1,6 +1,7 @@
 package requirements
 
 import (
+	"cf"
 	"cf/configuration"
 	"github.com/stretchr/testify/assert"
 	testterm "testhelpers/terminal"
@@ -14,15 +15,16 @@ func TestLoginRequirement(t *testing.T) {
 	}
 
 	req := newLoginRequirement(ui, config)
-	success := req.Execute()
-	assert.True(t, success)
+	err := req.Execute()
+	assert.NoError(t, err)
 
 	config = &configuration.Configuration{
 		AccessToken: "",
 	}
 
 	req = newLoginRequirement(ui, config)
-	success = req.Execute()
-	assert.False(t, success)
-	assert.Contains(t, ui.Outputs[0], "Not logged in.")
+	err = req.Execute()
+	assert.Equal(t, cf.ErrorKindOf(err), cf.AuthError)
+	assert.Equal(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Not logged in.")
 }
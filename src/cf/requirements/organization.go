@@ -27,16 +27,28 @@ func newOrganizationRequirement(name string, ui terminal.UI, sR api.Organization
 	return
 }
 
-func (req *organizationApiRequirement) Execute() (success bool) {
+func (req *organizationApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.org, apiResponse = req.orgRepo.FindByName(req.name)
 
 	if apiResponse.IsNotSuccessful() {
-		req.ui.Failed(apiResponse.Message)
-		return false
+		req.ui.Failed(notFoundMessage(apiResponse, req.name, req.orgNames))
+		return apiResponse.AsError()
 	}
 
-	return true
+	return
+}
+
+func (req *organizationApiRequirement) orgNames() (names []string) {
+	orgs, apiResponse := req.orgRepo.FindAll()
+	if apiResponse.IsNotSuccessful() {
+		return
+	}
+
+	for _, org := range orgs {
+		names = append(names, org.Name)
+	}
+	return
 }
 
 func (req *organizationApiRequirement) GetOrganization() cf.Organization {
@@ -14,9 +14,9 @@ func TestOrgReqExecute(t *testing.T) {
 	ui := new(testterm.FakeUI)
 
 	orgReq := newOrganizationRequirement("foo", ui, orgRepo)
-	success := orgReq.Execute()
+	err := orgReq.Execute()
 
-	assert.True(t, success)
+	assert.NoError(t, err)
 	assert.Equal(t, orgRepo.FindByNameName, "foo")
 	assert.Equal(t, orgReq.GetOrganization(), org)
 }
@@ -26,7 +26,20 @@ func TestOrgReqWhenOrgDoesNotExist(t *testing.T) {
 	ui := new(testterm.FakeUI)
 
 	orgReq := newOrganizationRequirement("foo", ui, orgRepo)
-	success := orgReq.Execute()
+	err := orgReq.Execute()
 
-	assert.False(t, success)
+	assert.Error(t, err)
+}
+
+func TestOrgReqSuggestsOrgsWithSimilarNames(t *testing.T) {
+	orgRepo := &testapi.FakeOrgRepository{
+		FindByNameNotFound: true,
+		Organizations:      []cf.Organization{cf.Organization{Name: "my-org"}, cf.Organization{Name: "other-org"}},
+	}
+	ui := new(testterm.FakeUI)
+
+	orgReq := newOrganizationRequirement("my-orgs", ui, orgRepo)
+	orgReq.Execute()
+
+	assert.Contains(t, ui.Outputs[1], "Org my-orgs not found\nDid you mean my-org?")
 }
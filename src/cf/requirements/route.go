@@ -29,16 +29,16 @@ func newRouteRequirement(host, domain string, ui terminal.UI, routeRepo api.Rout
 	return
 }
 
-func (req *routeApiRequirement) Execute() bool {
+func (req *routeApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.route, apiResponse = req.routeRepo.FindByHostAndDomain(req.host, req.domain)
 
 	if apiResponse.IsNotSuccessful() {
 		req.ui.Failed(apiResponse.Message)
-		return false
+		return apiResponse.AsError()
 	}
 
-	return true
+	return
 }
 
 func (req *routeApiRequirement) GetRoute() cf.Route {
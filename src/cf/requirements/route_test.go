@@ -18,9 +18,9 @@ func TestRouteReqExecute(t *testing.T) {
 	ui := new(testterm.FakeUI)
 
 	routeReq := newRouteRequirement("host", "example.com", ui, routeRepo)
-	success := routeReq.Execute()
+	err := routeReq.Execute()
 
-	assert.True(t, success)
+	assert.NoError(t, err)
 	assert.Equal(t, routeRepo.FindByHostAndDomainHost, "host")
 	assert.Equal(t, routeRepo.FindByHostAndDomainDomain, "example.com")
 	assert.Equal(t, routeReq.GetRoute(), route)
@@ -31,9 +31,9 @@ func TestRouteReqWhenRouteDoesNotExist(t *testing.T) {
 	ui := new(testterm.FakeUI)
 
 	routeReq := newRouteRequirement("host", "example.com", ui, routeRepo)
-	success := routeReq.Execute()
+	err := routeReq.Execute()
 
-	assert.False(t, success)
+	assert.Error(t, err)
 }
 
 func TestRouteReqOnError(t *testing.T) {
@@ -41,7 +41,7 @@ func TestRouteReqOnError(t *testing.T) {
 	ui := new(testterm.FakeUI)
 
 	routeReq := newRouteRequirement("host", "example.com", ui, routeRepo)
-	success := routeReq.Execute()
+	err := routeReq.Execute()
 
-	assert.False(t, success)
+	assert.Error(t, err)
 }
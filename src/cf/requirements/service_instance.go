@@ -16,27 +16,41 @@ type serviceInstanceApiRequirement struct {
 	name            string
 	ui              terminal.UI
 	serviceRepo     api.ServiceRepository
+	spaceRepo       api.SpaceRepository
 	serviceInstance cf.ServiceInstance
 }
 
-func newServiceInstanceRequirement(name string, ui terminal.UI, sR api.ServiceRepository) (req *serviceInstanceApiRequirement) {
+func newServiceInstanceRequirement(name string, ui terminal.UI, sR api.ServiceRepository, spaceRepo api.SpaceRepository) (req *serviceInstanceApiRequirement) {
 	req = new(serviceInstanceApiRequirement)
 	req.name = name
 	req.ui = ui
 	req.serviceRepo = sR
+	req.spaceRepo = spaceRepo
 	return
 }
 
-func (req *serviceInstanceApiRequirement) Execute() (success bool) {
+func (req *serviceInstanceApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.serviceInstance, apiResponse = req.serviceRepo.FindInstanceByName(req.name)
 
 	if apiResponse.IsNotSuccessful() {
-		req.ui.Failed(apiResponse.Message)
-		return false
+		req.ui.Failed(notFoundMessage(apiResponse, req.name, req.serviceInstanceNames))
+		return apiResponse.AsError()
 	}
 
-	return true
+	return
+}
+
+func (req *serviceInstanceApiRequirement) serviceInstanceNames() (names []string) {
+	space, apiResponse := req.spaceRepo.GetSummary()
+	if apiResponse.IsNotSuccessful() {
+		return
+	}
+
+	for _, instance := range space.ServiceInstances {
+		names = append(names, instance.Name)
+	}
+	return
 }
 
 func (req *serviceInstanceApiRequirement) GetServiceInstance() cf.ServiceInstance {
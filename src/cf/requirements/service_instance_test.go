@@ -13,10 +13,10 @@ func TestServiceInstanceReqExecute(t *testing.T) {
 	repo := &testapi.FakeServiceRepo{FindInstanceByNameServiceInstance: instance}
 	ui := new(testterm.FakeUI)
 
-	req := newServiceInstanceRequirement("foo", ui, repo)
-	success := req.Execute()
+	req := newServiceInstanceRequirement("foo", ui, repo, &testapi.FakeSpaceRepository{})
+	err := req.Execute()
 
-	assert.True(t, success)
+	assert.NoError(t, err)
 	assert.Equal(t, repo.FindInstanceByNameName, "foo")
 	assert.Equal(t, req.GetServiceInstance(), instance)
 }
@@ -25,8 +25,24 @@ func TestServiceInstanceReqExecuteWhenServiceInstanceNotFound(t *testing.T) {
 	repo := &testapi.FakeServiceRepo{FindInstanceByNameNotFound: true}
 	ui := new(testterm.FakeUI)
 
-	req := newServiceInstanceRequirement("foo", ui, repo)
-	success := req.Execute()
+	req := newServiceInstanceRequirement("foo", ui, repo, &testapi.FakeSpaceRepository{})
+	err := req.Execute()
 
-	assert.False(t, success)
+	assert.Error(t, err)
+}
+
+func TestServiceInstanceReqSuggestsInstancesWithSimilarNames(t *testing.T) {
+	repo := &testapi.FakeServiceRepo{FindInstanceByNameNotFound: true}
+	spaceRepo := &testapi.FakeSpaceRepository{
+		SummarySpace: cf.Space{ServiceInstances: []cf.ServiceInstance{
+			cf.ServiceInstance{Name: "my-db"},
+			cf.ServiceInstance{Name: "my-queue"},
+		}},
+	}
+	ui := new(testterm.FakeUI)
+
+	req := newServiceInstanceRequirement("my-bd", ui, repo, spaceRepo)
+	req.Execute()
+
+	assert.Contains(t, ui.Outputs[1], "Service instance my-bd not found\nDid you mean my-db?")
 }
@@ -27,16 +27,28 @@ func newSpaceRequirement(name string, ui terminal.UI, sR api.SpaceRepository) (r
 	return
 }
 
-func (req *spaceApiRequirement) Execute() (success bool) {
+func (req *spaceApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.space, apiResponse = req.spaceRepo.FindByName(req.name)
 
 	if apiResponse.IsNotSuccessful() {
-		req.ui.Failed(apiResponse.Message)
-		return false
+		req.ui.Failed(notFoundMessage(apiResponse, req.name, req.spaceNames))
+		return apiResponse.AsError()
 	}
 
-	return true
+	return
+}
+
+func (req *spaceApiRequirement) spaceNames() (names []string) {
+	spaces, apiResponse := req.spaceRepo.FindAll()
+	if apiResponse.IsNotSuccessful() {
+		return
+	}
+
+	for _, space := range spaces {
+		names = append(names, space.Name)
+	}
+	return
 }
 
 func (req *spaceApiRequirement) GetSpace() cf.Space {
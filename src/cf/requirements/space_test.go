@@ -14,9 +14,9 @@ func TestSpaceReqExecute(t *testing.T) {
 	ui := new(testterm.FakeUI)
 
 	spaceReq := newSpaceRequirement("foo", ui, spaceRepo)
-	success := spaceReq.Execute()
+	err := spaceReq.Execute()
 
-	assert.True(t, success)
+	assert.NoError(t, err)
 	assert.Equal(t, spaceRepo.FindByNameName, "foo")
 	assert.Equal(t, spaceReq.GetSpace(), space)
 }
@@ -26,7 +26,20 @@ func TestSpaceReqExecuteWhenSpaceNotFound(t *testing.T) {
 	ui := new(testterm.FakeUI)
 
 	spaceReq := newSpaceRequirement("foo", ui, spaceRepo)
-	success := spaceReq.Execute()
+	err := spaceReq.Execute()
 
-	assert.False(t, success)
+	assert.Error(t, err)
+}
+
+func TestSpaceReqSuggestsSpacesWithSimilarNames(t *testing.T) {
+	spaceRepo := &testapi.FakeSpaceRepository{
+		FindByNameNotFound: true,
+		Spaces:             []cf.Space{cf.Space{Name: "staging"}, cf.Space{Name: "production"}},
+	}
+	ui := new(testterm.FakeUI)
+
+	spaceReq := newSpaceRequirement("stagign", ui, spaceRepo)
+	spaceReq.Execute()
+
+	assert.Contains(t, ui.Outputs[1], "Space stagign not found\nDid you mean staging?")
 }
@@ -0,0 +1,21 @@
+package requirements
+
+import (
+	"cf"
+	"cf/net"
+)
+
+// The failure message for a missing app, space, org or service instance,
+// with the closest names there are when the name looks mistyped. Names are
+// only listed when the lookup found nothing.
+func notFoundMessage(apiResponse net.ApiResponse, name string, listNames func() []string) string {
+	if !apiResponse.IsNotFound() {
+		return apiResponse.Message
+	}
+
+	suggestions := cf.ClosestNames(name, listNames())
+	if len(suggestions) == 0 {
+		return apiResponse.Message
+	}
+	return apiResponse.Message + "\n" + cf.DidYouMean(suggestions)
+}
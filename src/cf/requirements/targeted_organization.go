@@ -3,6 +3,7 @@ package requirements
 import (
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/terminal"
 	"fmt"
 )
@@ -21,15 +22,15 @@ func newTargetedOrgRequirement(ui terminal.UI, config *configuration.Configurati
 	return targetedOrgApiRequirement{ui, config}
 }
 
-func (req targetedOrgApiRequirement) Execute() (success bool) {
+func (req targetedOrgApiRequirement) Execute() (err error) {
 	if !req.config.HasOrganization() {
-		message := fmt.Sprintf("No org targeted, use '%s' to target an org.",
+		message := fmt.Sprintf(i18n.T("targeted_organization.no_org_targeted"),
 			terminal.CommandColor(cf.Name+" target -o ORG"))
 		req.ui.Failed(message)
-		return false
+		return cf.NewCommandError(cf.GeneralError, "%s", message)
 	}
 
-	return true
+	return
 }
 
 func (req targetedOrgApiRequirement) GetOrganization() (org cf.Organization) {
@@ -18,14 +18,14 @@ func TestTargetedOrgRequirement(t *testing.T) {
 	}
 
 	req := newTargetedOrgRequirement(ui, config)
-	success := req.Execute()
-	assert.True(t, success)
+	err := req.Execute()
+	assert.NoError(t, err)
 
 	config.Organization = cf.Organization{}
 
 	req = newTargetedOrgRequirement(ui, config)
-	success = req.Execute()
-	assert.False(t, success)
+	err = req.Execute()
+	assert.Error(t, err)
 	assert.Contains(t, ui.Outputs[0], "FAILED")
 	assert.Contains(t, ui.Outputs[1], "No org targeted")
 }
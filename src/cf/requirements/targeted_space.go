@@ -3,6 +3,7 @@ package requirements
 import (
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/terminal"
 	"fmt"
 )
@@ -16,19 +17,19 @@ func newTargetedSpaceRequirement(ui terminal.UI, config *configuration.Configura
 	return TargetedSpaceRequirement{ui, config}
 }
 
-func (req TargetedSpaceRequirement) Execute() (success bool) {
+func (req TargetedSpaceRequirement) Execute() (err error) {
 	if !req.config.HasOrganization() {
-		message := fmt.Sprintf("No org and space targeted, use '%s' to target an org and space",
+		message := fmt.Sprintf(i18n.T("targeted_space.no_org_and_space_targeted"),
 			terminal.CommandColor(cf.Name+" target -o ORG -s SPACE"))
 		req.ui.Failed(message)
-		return false
+		return cf.NewCommandError(cf.GeneralError, "%s", message)
 	}
 
 	if !req.config.HasSpace() {
-		message := fmt.Sprintf("No space targeted, use '%s' to target a space", terminal.CommandColor("cf target -s"))
+		message := fmt.Sprintf(i18n.T("targeted_space.no_space_targeted"), terminal.CommandColor("cf target -s"))
 		req.ui.Failed(message)
-		return false
+		return cf.NewCommandError(cf.GeneralError, "%s", message)
 	}
 
-	return true
+	return
 }
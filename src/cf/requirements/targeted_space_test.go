@@ -22,14 +22,14 @@ func TestSpaceRequirement(t *testing.T) {
 	}
 
 	req := newTargetedSpaceRequirement(ui, config)
-	success := req.Execute()
-	assert.True(t, success)
+	err := req.Execute()
+	assert.NoError(t, err)
 
 	config.Space = cf.Space{}
 
 	req = newTargetedSpaceRequirement(ui, config)
-	success = req.Execute()
-	assert.False(t, success)
+	err = req.Execute()
+	assert.Error(t, err)
 	assert.Contains(t, ui.Outputs[0], "FAILED")
 	assert.Contains(t, ui.Outputs[1], "No space targeted")
 
@@ -37,8 +37,8 @@ func TestSpaceRequirement(t *testing.T) {
 	config.Organization = cf.Organization{}
 
 	req = newTargetedSpaceRequirement(ui, config)
-	success = req.Execute()
-	assert.False(t, success)
+	err = req.Execute()
+	assert.Error(t, err)
 	assert.Contains(t, ui.Outputs[0], "FAILED")
 	assert.Contains(t, ui.Outputs[1], "No org and space targeted")
 }
@@ -27,16 +27,16 @@ func newUserRequirement(username string, ui terminal.UI, userRepo api.UserReposi
 	return
 }
 
-func (req *userApiRequirement) Execute() (success bool) {
+func (req *userApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.user, apiResponse = req.userRepo.FindByUsername(req.username)
 
 	if apiResponse.IsNotSuccessful() {
 		req.ui.Failed(apiResponse.Message)
-		return false
+		return apiResponse.AsError()
 	}
 
-	return true
+	return
 }
 
 func (req *userApiRequirement) GetUser() cf.User {
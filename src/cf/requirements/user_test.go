@@ -15,9 +15,9 @@ func TestUserReqExecute(t *testing.T) {
 	ui := new(testterm.FakeUI)
 
 	userReq := newUserRequirement("foo", ui, userRepo)
-	success := userReq.Execute()
+	err := userReq.Execute()
 
-	assert.True(t, success)
+	assert.NoError(t, err)
 	assert.Equal(t, userRepo.FindByUsernameUsername, "foo")
 	assert.Equal(t, userReq.GetUser(), user)
 }
@@ -27,8 +27,8 @@ func TestUserReqWhenUserDoesNotExist(t *testing.T) {
 	ui := new(testterm.FakeUI)
 
 	userReq := newUserRequirement("foo", ui, userRepo)
-	success := userReq.Execute()
+	err := userReq.Execute()
 
-	assert.False(t, success)
+	assert.Error(t, err)
 	assert.Contains(t, ui.Outputs[0], "FAILED")
 }
@@ -1,7 +1,9 @@
 package requirements
 
 import (
+	"cf"
 	"cf/api"
+	"cf/i18n"
 	"cf/terminal"
 )
 
@@ -14,13 +16,20 @@ func newValidAccessTokenRequirement(ui terminal.UI, appRepo api.ApplicationRepos
 	return ValidAccessTokenRequirement{ui, appRepo}
 }
 
-func (req ValidAccessTokenRequirement) Execute() (success bool) {
+// A rejected token is reported as an expired session, which the runner offers
+// to fix by logging in again.
+func (req ValidAccessTokenRequirement) Execute() (err error) {
 	_, apiResponse := req.appRepo.FindByName("checking_for_valid_access_token")
 
-	if apiResponse.IsNotSuccessful() && apiResponse.StatusCode == 401 {
-		req.ui.Say(terminal.NotLoggedInText())
-		return false
+	if apiResponse.IsSessionExpired() || (apiResponse.IsNotSuccessful() && apiResponse.StatusCode == 401) {
+		message := apiResponse.Message
+		if !apiResponse.IsSessionExpired() {
+			message = i18n.T("authentication.session_expired")
+		}
+
+		req.ui.Failed("%s", message)
+		return cf.NewCommandError(cf.SessionExpiredError, "%s", message)
 	}
 
-	return true
+	return
 }
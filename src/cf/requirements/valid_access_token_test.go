@@ -1,6 +1,7 @@
 package requirements
 
 import (
+	"cf"
 	"github.com/stretchr/testify/assert"
 	testapi "testhelpers/api"
 	testterm "testhelpers/terminal"
@@ -14,13 +15,27 @@ func TestValidAccessRequirement(t *testing.T) {
 	}
 
 	req := newValidAccessTokenRequirement(ui, appRepo)
-	success := req.Execute()
-	assert.False(t, success)
-	assert.Contains(t, ui.Outputs[0], "Not logged in.")
+	err := req.Execute()
+	assert.Equal(t, cf.ErrorKindOf(err), cf.SessionExpiredError)
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Your session has expired.")
 
 	appRepo.FindByNameAuthErr = false
 
 	req = newValidAccessTokenRequirement(ui, appRepo)
-	success = req.Execute()
-	assert.True(t, success)
+	err = req.Execute()
+	assert.NoError(t, err)
+}
+
+func TestValidAccessRequirementWhenSessionHasExpired(t *testing.T) {
+	ui := new(testterm.FakeUI)
+	appRepo := &testapi.FakeApplicationRepository{
+		FindByNameSessionExpired: true,
+	}
+
+	req := newValidAccessTokenRequirement(ui, appRepo)
+	err := req.Execute()
+	assert.Equal(t, cf.ErrorKindOf(err), cf.SessionExpiredError)
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Your session has expired.")
 }
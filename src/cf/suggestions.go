@@ -0,0 +1,100 @@
+package cf
+
+import (
+	"cf/i18n"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const maxSuggestions = 3
+
+// The candidates within a few typos of a mistyped name, closest first. Names
+// are compared case-insensitively, and longer names may have more typos.
+func ClosestNames(name string, candidates []string) (names []string) {
+	maxDistance := len(name) / 3
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+
+	distances := map[string]int{}
+	for _, candidate := range candidates {
+		if _, seen := distances[candidate]; seen {
+			continue
+		}
+
+		distance := editDistance(strings.ToLower(name), strings.ToLower(candidate))
+		if distance <= maxDistance {
+			distances[candidate] = distance
+			names = append(names, candidate)
+		}
+	}
+
+	sort.Sort(byDistance{names, distances})
+	if len(names) > maxSuggestions {
+		names = names[:maxSuggestions]
+	}
+	return
+}
+
+// The message that follows a failure when there are close names, or "" if not
+func DidYouMean(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(i18n.T("suggestions.did_you_mean"), strings.Join(names, ", "))
+}
+
+// Levenshtein distance, counting a swap of neighbouring letters as one edit
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+
+	d := make([][]int, len(s)+1)
+	for i := range d {
+		d[i] = make([]int, len(t)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = smallestOf(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				d[i][j] = smallestOf(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(s)][len(t)]
+}
+
+func smallestOf(values ...int) (smallest int) {
+	smallest = values[0]
+	for _, value := range values[1:] {
+		if value < smallest {
+			smallest = value
+		}
+	}
+	return
+}
+
+type byDistance struct {
+	names     []string
+	distances map[string]int
+}
+
+func (s byDistance) Len() int      { return len(s.names) }
+func (s byDistance) Swap(i, j int) { s.names[i], s.names[j] = s.names[j], s.names[i] }
+func (s byDistance) Less(i, j int) bool {
+	di, dj := s.distances[s.names[i]], s.distances[s.names[j]]
+	if di != dj {
+		return di < dj
+	}
+	return s.names[i] < s.names[j]
+}
@@ -0,0 +1,27 @@
+package cf
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestClosestNames(t *testing.T) {
+	candidates := []string{"my-app", "my-api", "other-app", "my-app-worker"}
+
+	assert.Equal(t, ClosestNames("my-ap", candidates), []string{"my-api", "my-app"})
+	assert.Equal(t, ClosestNames("my-apq", candidates), []string{"my-api", "my-app"})
+	assert.Equal(t, ClosestNames("MY-APP", candidates), []string{"my-app", "my-api"})
+	assert.Equal(t, ClosestNames("ym-app", candidates), []string{"my-app", "my-api"})
+	assert.Equal(t, ClosestNames("other-app-worker", candidates), []string{"my-app-worker"})
+	assert.Empty(t, ClosestNames("database", candidates))
+}
+
+func TestClosestNamesReturnsAtMostThreeNames(t *testing.T) {
+	names := ClosestNames("app", []string{"app1", "app2", "app3", "app4", "app1"})
+	assert.Equal(t, names, []string{"app1", "app2", "app3"})
+}
+
+func TestDidYouMean(t *testing.T) {
+	assert.Equal(t, DidYouMean([]string{}), "")
+	assert.Equal(t, DidYouMean([]string{"my-app", "my-api"}), "Did you mean my-app, my-api?")
+}
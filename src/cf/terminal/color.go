@@ -2,8 +2,11 @@ package terminal
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"runtime"
+	"strings"
+	"sync"
 )
 
 type Color uint
@@ -19,8 +22,42 @@ const (
 	white   = 38
 )
 
+var (
+	detectColorsOnce sync.Once
+	colorsEnabled    bool
+)
+
+func ColorsEnabled() bool {
+	detectColorsOnce.Do(func() {
+		colorsEnabled = detectColorSupport()
+	})
+	return colorsEnabled
+}
+
+func SetColorsEnabled(enabled bool) {
+	detectColorsOnce.Do(func() {})
+	colorsEnabled = enabled
+}
+
+// CF_COLOR=true or false wins, then NO_COLOR. Otherwise colors are only used
+// when stdout is a terminal.
+func detectColorSupport() bool {
+	switch strings.ToLower(os.Getenv("CF_COLOR")) {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+
+	if os.Getenv("NO_COLOR") != "" || runtime.GOOS == "windows" {
+		return false
+	}
+
+	return stdoutIsTerminal()
+}
+
 func colorize(message string, color Color, bold bool) string {
-	if runtime.GOOS == "windows" {
+	if !ColorsEnabled() {
 		return message
 	}
 
@@ -2,17 +2,53 @@ package terminal
 
 import (
 	"github.com/stretchr/testify/assert"
-	"runtime"
+	"os"
 	"testing"
 )
 
 func TestColorize(t *testing.T) {
+	defer SetColorsEnabled(ColorsEnabled())
+	SetColorsEnabled(true)
+
 	text := "Hello World"
 	colorizedText := colorize(text, red, true)
 
-	if runtime.GOOS == "windows" {
-		assert.Equal(t, colorizedText, "Hello World")
-	} else {
-		assert.Equal(t, colorizedText, "\033[1;31mHello World\033[0m")
-	}
+	assert.Equal(t, colorizedText, "\033[1;31mHello World\033[0m")
+}
+
+func TestColorizeWhenColorsAreDisabled(t *testing.T) {
+	defer SetColorsEnabled(ColorsEnabled())
+	SetColorsEnabled(false)
+
+	assert.Equal(t, colorize("Hello World", red, true), "Hello World")
+}
+
+func TestDetectColorSupport(t *testing.T) {
+	defer os.Setenv("CF_COLOR", os.Getenv("CF_COLOR"))
+	defer os.Setenv("NO_COLOR", os.Getenv("NO_COLOR"))
+
+	os.Setenv("CF_COLOR", "")
+	os.Setenv("NO_COLOR", "1")
+	assert.False(t, detectColorSupport())
+
+	os.Setenv("CF_COLOR", "true")
+	assert.True(t, detectColorSupport())
+
+	os.Setenv("CF_COLOR", "false")
+	os.Setenv("NO_COLOR", "")
+	assert.False(t, detectColorSupport())
+}
+
+func TestColorsAreOffWhenStdoutIsNotATerminal(t *testing.T) {
+	defer os.Setenv("CF_COLOR", os.Getenv("CF_COLOR"))
+	defer os.Setenv("NO_COLOR", os.Getenv("NO_COLOR"))
+	os.Setenv("CF_COLOR", "")
+	os.Setenv("NO_COLOR", "")
+
+	var detected bool
+	captureOutput(func() {
+		detected = detectColorSupport()
+	})
+
+	assert.False(t, detected)
 }
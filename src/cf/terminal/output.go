@@ -0,0 +1,221 @@
+package terminal
+
+import (
+	"bytes"
+	"cf/i18n"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type OutputFormat string
+
+const (
+	OutputFormatText OutputFormat = "text"
+	OutputFormatJson OutputFormat = "json"
+	OutputFormatYaml OutputFormat = "yaml"
+)
+
+var (
+	outputFormat OutputFormat = OutputFormatText
+	quiet        bool
+	wide         bool
+)
+
+// Quiet output hides status messages, leaving results, warnings and errors.
+func SetQuiet(enabled bool) {
+	quiet = enabled
+}
+
+func IsQuiet() bool {
+	return quiet
+}
+
+// Wide output prints tables in full instead of truncating them to the terminal width.
+func SetWideOutput(enabled bool) {
+	wide = enabled
+}
+
+func IsWideOutput() bool {
+	return wide
+}
+
+// Selects how read commands print their results. An empty name keeps the default text output.
+func SetOutputFormat(name string) (err error) {
+	format := OutputFormat(strings.ToLower(name))
+	switch format {
+	case "":
+		outputFormat = OutputFormatText
+	case OutputFormatText, OutputFormatJson, OutputFormatYaml:
+		outputFormat = format
+	default:
+		err = fmt.Errorf(i18n.T("terminal.unknown_output_format"), name)
+	}
+	return
+}
+
+func CurrentOutputFormat() OutputFormat {
+	return outputFormat
+}
+
+func IsMachineReadableOutput() bool {
+	return outputFormat != OutputFormatText
+}
+
+type Renderer interface {
+	Render(w io.Writer, data interface{}) (err error)
+}
+
+func NewRenderer(format OutputFormat) (renderer Renderer, err error) {
+	switch format {
+	case OutputFormatJson:
+		renderer = JsonRenderer{}
+	case OutputFormatYaml:
+		renderer = YamlRenderer{}
+	default:
+		err = fmt.Errorf(i18n.T("terminal.no_renderer"), format)
+	}
+	return
+}
+
+type JsonRenderer struct{}
+
+func (renderer JsonRenderer) Render(w io.Writer, data interface{}) (err error) {
+	bytes, err := json.MarshalIndent(emptyIfNil(data), "", "  ")
+	if err != nil {
+		return
+	}
+
+	_, err = fmt.Fprintf(w, "%s\n", bytes)
+	return
+}
+
+// Renders the same document as the JSON renderer, with map keys sorted.
+type YamlRenderer struct{}
+
+func (renderer YamlRenderer) Render(w io.Writer, data interface{}) (err error) {
+	jsonBytes, err := json.Marshal(emptyIfNil(data))
+	if err != nil {
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+
+	var document interface{}
+	err = decoder.Decode(&document)
+	if err != nil {
+		return
+	}
+
+	buffer := &bytes.Buffer{}
+	writeYamlValue(buffer, document, 0)
+	_, err = w.Write(buffer.Bytes())
+	return
+}
+
+// Nil slices are rendered as empty lists, so an empty result is never null.
+func emptyIfNil(data interface{}) interface{} {
+	value := reflect.ValueOf(data)
+	if value.Kind() == reflect.Slice && value.IsNil() {
+		return reflect.MakeSlice(value.Type(), 0, 0).Interface()
+	}
+	return data
+}
+
+func writeYamlValue(buffer *bytes.Buffer, value interface{}, indent int) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if len(value) == 0 {
+			buffer.WriteString(strings.Repeat(" ", indent) + "{}\n")
+			return
+		}
+
+		keys := []string{}
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			buffer.WriteString(strings.Repeat(" ", indent) + yamlString(key) + ":")
+			writeYamlChild(buffer, value[key], indent)
+		}
+	case []interface{}:
+		if len(value) == 0 {
+			buffer.WriteString(strings.Repeat(" ", indent) + "[]\n")
+			return
+		}
+
+		for _, item := range value {
+			if item, ok := item.(map[string]interface{}); ok && len(item) > 0 {
+				// Start the item's first key on the same line as its dash
+				itemBuffer := &bytes.Buffer{}
+				writeYamlValue(itemBuffer, item, indent+2)
+				buffer.WriteString(strings.Repeat(" ", indent) + "- ")
+				buffer.Write(itemBuffer.Bytes()[indent+2:])
+				continue
+			}
+
+			buffer.WriteString(strings.Repeat(" ", indent) + "-")
+			writeYamlChild(buffer, item, indent)
+		}
+	default:
+		buffer.WriteString(strings.Repeat(" ", indent) + yamlScalar(value) + "\n")
+	}
+}
+
+// Writes a value following a "key:" or "-" marker: scalars and empty
+// collections on the same line, anything else as an indented block.
+func writeYamlChild(buffer *bytes.Buffer, value interface{}, indent int) {
+	switch child := value.(type) {
+	case map[string]interface{}:
+		if len(child) == 0 {
+			buffer.WriteString(" {}\n")
+			return
+		}
+	case []interface{}:
+		if len(child) == 0 {
+			buffer.WriteString(" []\n")
+			return
+		}
+	default:
+		buffer.WriteString(" " + yamlScalar(value) + "\n")
+		return
+	}
+
+	buffer.WriteString("\n")
+	writeYamlValue(buffer, value, indent+2)
+}
+
+func yamlScalar(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(value)
+	case json.Number:
+		return value.String()
+	case string:
+		return yamlString(value)
+	}
+	return yamlString(fmt.Sprintf("%v", value))
+}
+
+var (
+	plainYamlString    = regexp.MustCompile(`^[A-Za-z_/][A-Za-z0-9_./@+ -]*$`)
+	reservedYamlString = regexp.MustCompile(`^(?i:true|false|yes|no|on|off|y|n|null|~)$`)
+)
+
+// Strings are left unquoted unless YAML could read them as something else.
+func yamlString(value string) string {
+	if plainYamlString.MatchString(value) && !reservedYamlString.MatchString(value) && !strings.HasSuffix(value, " ") {
+		return value
+	}
+	return strconv.Quote(value)
+}
@@ -0,0 +1,158 @@
+package terminal
+
+import (
+	"bytes"
+	"cf"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSetOutputFormat(t *testing.T) {
+	defer SetOutputFormat("text")
+
+	err := SetOutputFormat("JSON")
+	assert.NoError(t, err)
+	assert.Equal(t, CurrentOutputFormat(), OutputFormatJson)
+	assert.True(t, IsMachineReadableOutput())
+
+	err = SetOutputFormat("")
+	assert.NoError(t, err)
+	assert.Equal(t, CurrentOutputFormat(), OutputFormatText)
+	assert.False(t, IsMachineReadableOutput())
+
+	err = SetOutputFormat("xml")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Unknown output format xml")
+	assert.Equal(t, CurrentOutputFormat(), OutputFormatText)
+}
+
+func TestJsonRendererUsesDocumentedFieldNames(t *testing.T) {
+	apps := []cf.Application{
+		cf.Application{
+			Name:      "my-app",
+			Guid:      "my-app-guid",
+			State:     "started",
+			Instances: 2,
+			Memory:    256,
+			Urls:      []string{"my-app.example.com"},
+		},
+	}
+
+	out := &bytes.Buffer{}
+	err := JsonRenderer{}.Render(out, apps)
+	assert.NoError(t, err)
+
+	assert.Contains(t, out.String(), `"name": "my-app"`)
+	assert.Contains(t, out.String(), `"guid": "my-app-guid"`)
+	assert.Contains(t, out.String(), `"running_instances": 0`)
+	assert.Contains(t, out.String(), `"memory": 256`)
+	assert.Contains(t, out.String(), `"my-app.example.com"`)
+	assert.NotContains(t, out.String(), "environment_vars")
+}
+
+func TestRenderersPrintEmptyListsForNilSlices(t *testing.T) {
+	var routes []cf.Route
+
+	out := &bytes.Buffer{}
+	JsonRenderer{}.Render(out, routes)
+	assert.Equal(t, out.String(), "[]\n")
+
+	out = &bytes.Buffer{}
+	YamlRenderer{}.Render(out, routes)
+	assert.Equal(t, out.String(), "[]\n")
+}
+
+func TestYamlRenderer(t *testing.T) {
+	events := []cf.Event{
+		cf.Event{
+			InstanceIndex:   1,
+			Timestamp:       time.Date(2014, 1, 2, 3, 4, 5, 0, time.UTC),
+			ExitDescription: "app instance exited",
+			ExitStatus:      -1,
+		},
+	}
+
+	out := &bytes.Buffer{}
+	err := YamlRenderer{}.Render(out, events)
+	assert.NoError(t, err)
+
+	assert.Equal(t, out.String(), `- exit_description: app instance exited
+  exit_status: -1
+  instance_index: 1
+  timestamp: "2014-01-02T03:04:05Z"
+`)
+}
+
+func TestYamlRendererNestsCollections(t *testing.T) {
+	route := cf.Route{
+		Host:     "www",
+		Domain:   cf.Domain{Name: "example.com", Shared: true},
+		AppNames: []string{"app-1", "true"},
+	}
+
+	out := &bytes.Buffer{}
+	err := YamlRenderer{}.Render(out, route)
+	assert.NoError(t, err)
+
+	assert.Equal(t, out.String(), `app_names:
+  - app-1
+  - "true"
+domain:
+  guid: ""
+  name: example.com
+  shared: true
+  spaces: null
+guid: ""
+host: www
+`)
+}
+
+func TestRenderIsSilentForTextOutput(t *testing.T) {
+	SetOutputFormat("text")
+	ui := new(terminalUI)
+
+	out := captureOutput(func() {
+		ui.Render([]cf.Organization{cf.Organization{Name: "my-org"}})
+	})
+
+	assert.Equal(t, out, "")
+}
+
+func TestRenderFailsForDataItCannotEncode(t *testing.T) {
+	SetOutputFormat("json")
+	defer SetOutputFormat("text")
+	ui := new(terminalUI)
+
+	var err error
+	errors := captureErrors(func() {
+		captureOutput(func() {
+			err = ui.Render(make(chan int))
+		})
+	})
+
+	assert.Equal(t, cf.ErrorKindOf(err), cf.GeneralError)
+	assert.Contains(t, errors, "FAILED")
+	assert.Contains(t, errors, "Error rendering json output.")
+}
+
+func TestRenderKeepsMessagesOutOfStdout(t *testing.T) {
+	SetOutputFormat("json")
+	defer SetOutputFormat("text")
+	ui := new(terminalUI)
+
+	out := captureOutput(func() {
+		ui.Say("Getting orgs...")
+		ui.Ok()
+		ui.Render([]cf.Organization{cf.Organization{Name: "my-org", Guid: "my-org-guid"}})
+		ui.DisplayTable([][]string{{"name"}, {"my-org"}})
+	})
+
+	assert.Equal(t, out, `[
+  {
+    "name": "my-org",
+    "guid": "my-org-guid"
+  }
+]
+`)
+}
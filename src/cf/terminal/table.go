@@ -0,0 +1,207 @@
+package terminal
+
+import (
+	"cf"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	tableColumnSeparator = "   "
+	minTableColumnWidth  = 8
+	ellipsis             = "…"
+)
+
+// Returns the width of the terminal stdout is attached to, or 0 when it is not
+// a terminal. Replaced in tests.
+var terminalWidth = func() int {
+	width := stdoutTerminalWidth()
+	if width > 0 {
+		return width
+	}
+
+	if !stdoutIsTerminal() {
+		return 0
+	}
+
+	width, _ = strconv.Atoi(os.Getenv("COLUMNS"))
+	return width
+}
+
+func stdoutIsTerminal() bool {
+	stat, err := os.Stdout.Stat()
+	return err == nil && stat.Mode()&os.ModeCharDevice != 0
+}
+
+// Fails with a usage error when --sort, --filter or --columns name a field
+// the table does not have.
+func (ui terminalUI) DisplayTable(table [][]string) (err error) {
+	if IsMachineReadableOutput() || len(table) == 0 {
+		return
+	}
+
+	table, err = tableOptions.Apply(table)
+	if err != nil {
+		ui.Failed("%s", err.Error())
+		err = cf.NewCommandError(cf.UsageError, "%s", err.Error())
+		return
+	}
+
+	columnWidths := tableColumnWidths(table)
+	if !IsWideOutput() {
+		fitColumnsToWidth(columnWidths, terminalWidth())
+	}
+
+	for row, line := range table {
+		cells := []string{}
+		for col, value := range line {
+			if displayWidth(value) > columnWidths[col] {
+				value = truncateToWidth(value, columnWidths[col])
+			}
+
+			padding := ""
+			if col < len(line)-1 {
+				padding = strings.Repeat(" ", columnWidths[col]-displayWidth(value))
+			}
+			cells = append(cells, tableColoringFunc(value, row, col)+padding)
+		}
+		fmt.Println(strings.Join(cells, tableColumnSeparator))
+	}
+	return
+}
+
+func tableColumnWidths(table [][]string) (widths []int) {
+	widths = make([]int, len(table[0]))
+	for _, line := range table {
+		for index, value := range line {
+			cellWidth := displayWidth(value)
+			if widths[index] < cellWidth {
+				widths[index] = cellWidth
+			}
+		}
+	}
+	return
+}
+
+// Narrows the widest columns one character at a time until the table fits,
+// leaving every column at least minTableColumnWidth wide. A width of 0 means
+// the output is not a terminal and is never truncated.
+func fitColumnsToWidth(widths []int, maxWidth int) {
+	if maxWidth <= 0 {
+		return
+	}
+
+	available := maxWidth - len(tableColumnSeparator)*(len(widths)-1)
+	total := 0
+	for _, width := range widths {
+		total += width
+	}
+
+	for total > available {
+		widest := 0
+		for index, width := range widths {
+			if width > widths[widest] {
+				widest = index
+			}
+		}
+
+		if widths[widest] <= minTableColumnWidth {
+			return
+		}
+
+		widths[widest]--
+		total--
+	}
+}
+
+// The number of terminal cells a string takes up once color codes are removed.
+func displayWidth(value string) (width int) {
+	for _, r := range decolorize(value) {
+		width += runeWidth(r)
+	}
+	return
+}
+
+var leadingColorCode = regexp.MustCompile(`^\x1B\[([0-9]{1,2}(;[0-9]{1,2})?)?[m|K]`)
+
+// Shortens the value so that it fits in width cells, ending it with an ellipsis.
+// Color codes are kept, and reset after the ellipsis in case the one closing
+// them was cut off.
+func truncateToWidth(value string, width int) string {
+	if displayWidth(value) <= width {
+		return value
+	}
+	if width <= 0 {
+		return ""
+	}
+
+	available := width - runeWidth([]rune(ellipsis)[0])
+	result := ""
+	used := 0
+	colored := false
+	for value != "" {
+		if code := leadingColorCode.FindString(value); code != "" {
+			result += code
+			value = value[len(code):]
+			colored = true
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(value)
+		if used+runeWidth(r) > available {
+			break
+		}
+		result += value[:size]
+		value = value[size:]
+		used += runeWidth(r)
+	}
+
+	result = strings.TrimRightFunc(result, unicode.IsSpace) + ellipsis
+	if colored {
+		result += "\033[0m"
+	}
+	return result
+}
+
+func runeWidth(r rune) int {
+	switch {
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf), unicode.IsControl(r):
+		return 0
+	case isEastAsianWide(r):
+		return 2
+	}
+	return 1
+}
+
+// East Asian wide and fullwidth characters, which take up two terminal cells
+var eastAsianWideRanges = []struct{ first, last rune }{
+	{0x1100, 0x115F},   // Hangul Jamo
+	{0x2E80, 0x303E},   // CJK radicals, Kangxi radicals and CJK symbols
+	{0x3041, 0x33FF},   // Hiragana, Katakana, Bopomofo and CJK compatibility
+	{0x3400, 0x4DBF},   // CJK unified ideographs extension A
+	{0x4E00, 0x9FFF},   // CJK unified ideographs
+	{0xA000, 0xA4CF},   // Yi syllables and radicals
+	{0xAC00, 0xD7A3},   // Hangul syllables
+	{0xF900, 0xFAFF},   // CJK compatibility ideographs
+	{0xFE30, 0xFE4F},   // CJK compatibility forms
+	{0xFF00, 0xFF60},   // Fullwidth forms
+	{0xFFE0, 0xFFE6},   // Fullwidth signs
+	{0x1F300, 0x1F64F}, // Pictographs and emoticons
+	{0x1F900, 0x1F9FF}, // Supplemental symbols and pictographs
+	{0x20000, 0x2FFFD}, // CJK unified ideographs extensions B and later
+	{0x30000, 0x3FFFD},
+}
+
+func isEastAsianWide(r rune) bool {
+	for _, wideRange := range eastAsianWideRanges {
+		if r >= wideRange.first && r <= wideRange.last {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,215 @@
+package terminal
+
+import (
+	"cf/i18n"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Sorting, filtering and column selection for every table. Fields are the
+// names in a table's header row.
+type TableOptions struct {
+	SortField  string
+	Descending bool
+	Filters    []TableFilter
+	Columns    []string
+}
+
+type TableFilter struct {
+	Field   string
+	Pattern string
+	matcher *regexp.Regexp
+}
+
+var tableOptions TableOptions
+
+func HasTableOptions() bool {
+	return tableOptions.SortField != "" || len(tableOptions.Filters) > 0 || len(tableOptions.Columns) > 0
+}
+
+// Parses the --sort, --filter and --columns flags. A sort field starting with
+// "-" sorts in descending order, and filters take the form field=glob.
+func SetTableOptions(sortField string, filters []string, columns string) (err error) {
+	options, err := NewTableOptions(sortField, filters, columns)
+	if err != nil {
+		return
+	}
+
+	tableOptions = options
+	return
+}
+
+func NewTableOptions(sortField string, filters []string, columns string) (options TableOptions, err error) {
+	options.SortField = strings.TrimPrefix(sortField, "-")
+	options.Descending = strings.HasPrefix(sortField, "-")
+
+	for _, filter := range filters {
+		parts := strings.SplitN(filter, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			err = fmt.Errorf(i18n.T("terminal.invalid_filter"), filter)
+			return
+		}
+
+		options.Filters = append(options.Filters, TableFilter{
+			Field:   parts[0],
+			Pattern: parts[1],
+			matcher: globToRegexp(parts[1]),
+		})
+	}
+
+	for _, column := range strings.Split(columns, ",") {
+		column = strings.TrimSpace(column)
+		if column != "" {
+			options.Columns = append(options.Columns, column)
+		}
+	}
+	return
+}
+
+// Returns a copy of the table with the options applied. Fails if an option
+// names a field the table does not have.
+func (options TableOptions) Apply(table [][]string) (result [][]string, err error) {
+	if len(table) == 0 {
+		return table, nil
+	}
+
+	header := table[0]
+	rows := [][]string{}
+
+	filterColumns := []int{}
+	for _, filter := range options.Filters {
+		var index int
+		index, err = tableFieldIndex(header, filter.Field)
+		if err != nil {
+			return
+		}
+		filterColumns = append(filterColumns, index)
+	}
+
+	for _, row := range table[1:] {
+		if options.matches(row, filterColumns) {
+			rows = append(rows, row)
+		}
+	}
+
+	if options.SortField != "" {
+		var index int
+		index, err = tableFieldIndex(header, options.SortField)
+		if err != nil {
+			return
+		}
+		sort.Stable(tableRowSorter{rows: rows, column: index, descending: options.Descending})
+	}
+
+	result = append([][]string{header}, rows...)
+	if len(options.Columns) == 0 {
+		return
+	}
+
+	columnIndexes := []int{}
+	for _, column := range options.Columns {
+		var index int
+		index, err = tableFieldIndex(header, column)
+		if err != nil {
+			return
+		}
+		columnIndexes = append(columnIndexes, index)
+	}
+
+	for rowIndex, row := range result {
+		selected := []string{}
+		for _, index := range columnIndexes {
+			selected = append(selected, row[index])
+		}
+		result[rowIndex] = selected
+	}
+	return
+}
+
+func (options TableOptions) matches(row []string, filterColumns []int) bool {
+	for i, filter := range options.Filters {
+		if !filter.matcher.MatchString(decolorize(row[filterColumns[i]])) {
+			return false
+		}
+	}
+	return true
+}
+
+func tableFieldIndex(header []string, field string) (index int, err error) {
+	for index, name := range header {
+		if strings.EqualFold(decolorize(name), field) {
+			return index, nil
+		}
+	}
+
+	err = fmt.Errorf(i18n.T("terminal.unknown_field"), field, decolorize(strings.Join(header, ", ")))
+	return
+}
+
+// "*" matches any text and "?" any single character.
+func globToRegexp(pattern string) *regexp.Regexp {
+	expression := regexp.QuoteMeta(pattern)
+	expression = strings.Replace(expression, `\*`, ".*", -1)
+	expression = strings.Replace(expression, `\?`, ".", -1)
+	return regexp.MustCompile("^" + expression + "$")
+}
+
+type tableRowSorter struct {
+	rows       [][]string
+	column     int
+	descending bool
+}
+
+func (sorter tableRowSorter) Len() int { return len(sorter.rows) }
+func (sorter tableRowSorter) Swap(i, j int) {
+	sorter.rows[i], sorter.rows[j] = sorter.rows[j], sorter.rows[i]
+}
+func (sorter tableRowSorter) Less(i, j int) bool {
+	a := decolorize(sorter.rows[i][sorter.column])
+	b := decolorize(sorter.rows[j][sorter.column])
+	if sorter.descending {
+		a, b = b, a
+	}
+	return lessTableValue(a, b)
+}
+
+var numericTableValue = regexp.MustCompile(`^(\d+(?:\.\d+)?)([KMGT]?)(?:/\d+)?$`)
+
+var tableValueUnits = map[string]float64{
+	"":  1,
+	"K": 1 << 10,
+	"M": 1 << 20,
+	"G": 1 << 30,
+	"T": 1 << 40,
+}
+
+// Numbers, byte sizes such as 256M and instance counts such as 1/2 sort
+// numerically, before any text. Text sorts case-insensitively.
+func lessTableValue(a, b string) bool {
+	numberA, aIsNumber := parseTableNumber(a)
+	numberB, bIsNumber := parseTableNumber(b)
+
+	switch {
+	case aIsNumber && bIsNumber:
+		return numberA < numberB
+	case aIsNumber != bIsNumber:
+		return aIsNumber
+	}
+	return strings.ToLower(a) < strings.ToLower(b)
+}
+
+func parseTableNumber(value string) (number float64, ok bool) {
+	match := numericTableValue.FindStringSubmatch(value)
+	if match == nil {
+		return
+	}
+
+	number, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return
+	}
+	return number * tableValueUnits[match[2]], true
+}
@@ -0,0 +1,125 @@
+package terminal
+
+import (
+	"cf"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+var appsTable = [][]string{
+	{"name", "state", "instances", "memory", "urls"},
+	{"app-b", "started", "2/2", "1G", "app-b.example.com"},
+	{"app-a", "stopped", "0/1", "256M", "app-a.example.com"},
+	{"app-c", "stopped", "0/3", "512M", "app-c.example.com, app-c.example.org"},
+}
+
+func TestTableOptionsSortNumerically(t *testing.T) {
+	options, err := NewTableOptions("memory", []string{}, "")
+	assert.NoError(t, err)
+
+	table, err := options.Apply(appsTable)
+	assert.NoError(t, err)
+	assert.Equal(t, tableColumn(table, 0), []string{"name", "app-a", "app-c", "app-b"})
+
+	options, err = NewTableOptions("-instances", []string{}, "")
+	assert.NoError(t, err)
+
+	table, err = options.Apply(appsTable)
+	assert.NoError(t, err)
+	assert.Equal(t, tableColumn(table, 0), []string{"name", "app-b", "app-a", "app-c"})
+}
+
+func TestTableOptionsSortText(t *testing.T) {
+	options, _ := NewTableOptions("Name", []string{}, "")
+
+	table, err := options.Apply(appsTable)
+	assert.NoError(t, err)
+	assert.Equal(t, tableColumn(table, 0), []string{"name", "app-a", "app-b", "app-c"})
+}
+
+func TestTableOptionsFilter(t *testing.T) {
+	options, err := NewTableOptions("memory", []string{"state=stopped", "urls=*.example.org"}, "")
+	assert.NoError(t, err)
+
+	table, err := options.Apply(appsTable)
+	assert.NoError(t, err)
+	assert.Equal(t, tableColumn(table, 0), []string{"name", "app-c"})
+
+	options, _ = NewTableOptions("", []string{"name=app-?"}, "")
+	table, _ = options.Apply(appsTable)
+	assert.Equal(t, len(table), 4)
+}
+
+func TestTableOptionsColumns(t *testing.T) {
+	options, err := NewTableOptions("", []string{"state=started"}, "urls, name")
+	assert.NoError(t, err)
+
+	table, err := options.Apply(appsTable)
+	assert.NoError(t, err)
+	assert.Equal(t, table, [][]string{
+		{"urls", "name"},
+		{"app-b.example.com", "app-b"},
+	})
+	assert.Equal(t, appsTable[0][0], "name")
+}
+
+func TestTableOptionsErrors(t *testing.T) {
+	_, err := NewTableOptions("", []string{"stopped"}, "")
+	assert.Error(t, err)
+
+	options, _ := NewTableOptions("size", []string{}, "")
+	_, err = options.Apply(appsTable)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Unknown field size")
+
+	options, _ = NewTableOptions("", []string{}, "name,size")
+	_, err = options.Apply(appsTable)
+	assert.Error(t, err)
+}
+
+func TestDisplayTableAppliesTheTableOptions(t *testing.T) {
+	SetColorsEnabled(false)
+	err := SetTableOptions("name", []string{"state=stopped"}, "name,memory")
+	assert.NoError(t, err)
+	defer SetTableOptions("", []string{}, "")
+
+	withTerminalWidth(0, func() {
+		output := captureOutput(func() {
+			NewUI().DisplayTable(appsTable)
+		})
+
+		assert.Equal(t, strings.Split(output, "\n"), []string{
+			"name    memory",
+			"app-a   256M",
+			"app-c   512M",
+			"",
+		})
+	})
+}
+
+func TestDisplayTableFailsForAnUnknownField(t *testing.T) {
+	SetColorsEnabled(false)
+	err := SetTableOptions("size", []string{}, "")
+	assert.NoError(t, err)
+	defer SetTableOptions("", []string{}, "")
+
+	var output string
+	errors := captureErrors(func() {
+		output = captureOutput(func() {
+			err = NewUI().DisplayTable(appsTable)
+		})
+	})
+
+	assert.Equal(t, cf.ErrorKindOf(err), cf.UsageError)
+	assert.Equal(t, output, "")
+	assert.Contains(t, errors, "FAILED")
+	assert.Contains(t, errors, "Unknown field size. Use one of: name, state, instances, memory, urls")
+}
+
+func tableColumn(table [][]string, index int) (values []string) {
+	for _, row := range table {
+		values = append(values, row[index])
+	}
+	return
+}
@@ -0,0 +1,105 @@
+package terminal
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	assert.Equal(t, displayWidth("my-app"), 6)
+	assert.Equal(t, displayWidth("café"), 4)
+	assert.Equal(t, displayWidth("cafe\u0301"), 4)
+	assert.Equal(t, displayWidth("日本語"), 6)
+	assert.Equal(t, displayWidth("\033[1;36mmy-app\033[0m"), 6)
+}
+
+func TestTruncateToWidth(t *testing.T) {
+	assert.Equal(t, truncateToWidth("my-app", 6), "my-app")
+	assert.Equal(t, truncateToWidth("my-app.example.com", 8), "my-app.…")
+	assert.Equal(t, truncateToWidth("日本語アプリ", 6), "日本…")
+	assert.Equal(t, displayWidth(truncateToWidth("日本語アプリ", 6)), 5)
+}
+
+func TestTruncateToWidthKeepsColors(t *testing.T) {
+	assert.Equal(t, truncateToWidth("\033[0;32mrunning-for-a-while\033[0m", 8), "\033[0;32mrunning…\033[0m")
+	assert.Equal(t, displayWidth(truncateToWidth("\033[0;32mrunning-for-a-while\033[0m", 8)), 8)
+	assert.Equal(t, truncateToWidth("my-app \033[0;31mcrashed\033[0m", 9), "my-app \033[0;31mc…\033[0m")
+}
+
+func TestDisplayTableAlignsWideCharacters(t *testing.T) {
+	SetColorsEnabled(false)
+	withTerminalWidth(0, func() {
+		output := captureOutput(func() {
+			NewUI().DisplayTable([][]string{
+				{"name", "state"},
+				{"日本語", "started"},
+				{"café", "stopped"},
+			})
+		})
+
+		assert.Equal(t, strings.Split(output, "\n"), []string{
+			"name     state",
+			"日本語   started",
+			"café     stopped",
+			"",
+		})
+	})
+}
+
+func TestDisplayTableTruncatesToTheTerminalWidth(t *testing.T) {
+	SetColorsEnabled(false)
+	table := [][]string{
+		{"name", "urls"},
+		{"my-app", "my-app.example.com, my-app-staging.example.com"},
+	}
+
+	withTerminalWidth(30, func() {
+		output := captureOutput(func() {
+			NewUI().DisplayTable(table)
+		})
+
+		assert.Equal(t, strings.Split(output, "\n"), []string{
+			"name     urls",
+			"my-app   my-app.example.com,…",
+			"",
+		})
+	})
+}
+
+func TestDisplayTableWithWideOutput(t *testing.T) {
+	SetColorsEnabled(false)
+	SetWideOutput(true)
+	defer SetWideOutput(false)
+
+	withTerminalWidth(30, func() {
+		output := captureOutput(func() {
+			NewUI().DisplayTable([][]string{
+				{"name", "urls"},
+				{"my-app", "my-app.example.com, my-app-staging.example.com"},
+			})
+		})
+
+		assert.Contains(t, output, "my-app   my-app.example.com, my-app-staging.example.com\n")
+	})
+}
+
+func TestFitColumnsToWidthKeepsAMinimumWidth(t *testing.T) {
+	widths := []int{20, 40}
+	fitColumnsToWidth(widths, 10)
+	assert.Equal(t, widths, []int{minTableColumnWidth, minTableColumnWidth})
+
+	widths = []int{20, 40}
+	fitColumnsToWidth(widths, 0)
+	assert.Equal(t, widths, []int{20, 40})
+}
+
+func withTerminalWidth(width int, block func()) {
+	oldTerminalWidth := terminalWidth
+	terminalWidth = func() int { return width }
+	defer func() {
+		terminalWidth = oldTerminalWidth
+	}()
+
+	block()
+}
@@ -3,6 +3,7 @@ package terminal
 import (
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"fmt"
 	"github.com/codegangsta/cli"
 	"io"
@@ -14,15 +15,21 @@ import (
 type ColoringFunction func(value string, row int, col int) string
 
 func NotLoggedInText() string {
-	return fmt.Sprintf("Not logged in. Use '%s' to log in.", CommandColor(cf.Name+" login"))
+	return fmt.Sprintf(i18n.T("terminal.not_logged_in"), CommandColor(cf.Name+" login"))
 }
 
+// Say, DisplayTable, ShowConfiguration and Render write a command's results to
+// stdout. Everything else is a diagnostic and goes to stderr, and Status, Ok and
+// LoadingIndication are hidden by --quiet. Failed and FailWithUsage only report
+// a failure; the command still has to return an error.
 type UI interface {
 	Say(message string, args ...interface{})
+	Status(message string, args ...interface{})
 	Warn(message string, args ...interface{})
 	Ask(prompt string, args ...interface{}) (answer string)
 	AskForPassword(prompt string, args ...interface{}) (answer string)
 	Confirm(message string, args ...interface{}) bool
+	IsInteractive() bool
 	Ok()
 	Failed(message string, args ...interface{})
 	FailWithUsage(ctxt *cli.Context, cmdName string)
@@ -30,7 +37,8 @@ type UI interface {
 	ShowConfiguration(*configuration.Configuration)
 	LoadingIndication()
 	Wait(duration time.Duration)
-	DisplayTable(table [][]string)
+	DisplayTable(table [][]string) (err error)
+	Render(data interface{}) (err error)
 }
 
 type terminalUI struct {
@@ -43,108 +51,138 @@ func NewUI() UI {
 }
 
 func (c terminalUI) Say(message string, args ...interface{}) {
-	fmt.Printf(message+"\n", args...)
+	// With machine-readable output the text form of the results is only a diagnostic
+	if IsMachineReadableOutput() {
+		c.Status(message, args...)
+		return
+	}
+
+	fmt.Fprintf(os.Stdout, message+"\n", args...)
+	return
+}
+
+func (c terminalUI) Status(message string, args ...interface{}) {
+	if IsQuiet() {
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, message+"\n", args...)
 	return
 }
 
 func (c terminalUI) Warn(message string, args ...interface{}) {
 	message = fmt.Sprintf(message, args...)
-	c.Say(WarningColor(message))
+	fmt.Fprintln(os.Stderr, WarningColor(message))
 	return
 }
 
 func (c terminalUI) Confirm(message string, args ...interface{}) bool {
-	response := c.Ask(message, args...)
-	switch strings.ToLower(response) {
-	case "y", "yes":
-		return true
+	response := strings.ToLower(c.Ask(message, args...))
+
+	// English answers are accepted whatever the language
+	answers := strings.Split("y,yes,"+i18n.T("terminal.confirm_answers"), ",")
+	for _, answer := range answers {
+		if response != "" && response == answer {
+			return true
+		}
 	}
 	return false
 }
 
 func (c terminalUI) Ask(prompt string, args ...interface{}) (answer string) {
-	fmt.Println("")
-	fmt.Printf(prompt+" ", args...)
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintf(os.Stderr, prompt+" ", args...)
 	fmt.Fscanln(stdin, &answer)
 	return
 }
 
+func (c terminalUI) IsInteractive() bool {
+	stat, err := os.Stdin.Stat()
+	return err == nil && stat.Mode()&os.ModeCharDevice != 0
+}
+
 func (c terminalUI) Ok() {
-	c.Say(SuccessColor("OK"))
+	c.Status(SuccessColor(i18n.T("terminal.ok")))
 }
 
 func (c terminalUI) Failed(message string, args ...interface{}) {
 	message = fmt.Sprintf(message, args...)
-	c.Say(FailureColor("FAILED"))
-	c.Say(message)
-	os.Exit(1)
+	fmt.Fprintln(os.Stderr, FailureColor(i18n.T("terminal.failed")))
+	fmt.Fprintln(os.Stderr, message)
 }
 
 func (c terminalUI) FailWithUsage(ctxt *cli.Context, cmdName string) {
-	c.Say(FailureColor("FAILED"))
-	c.Say("Incorrect Usage.\n")
+	fmt.Fprintln(os.Stderr, FailureColor(i18n.T("terminal.failed")))
+	fmt.Fprint(os.Stderr, i18n.T("terminal.incorrect_usage")+"\n\n")
+
+	// cli prints help to stdout
+	stdout := os.Stdout
+	os.Stdout = os.Stderr
 	cli.ShowCommandHelp(ctxt, cmdName)
-	c.Say("")
-	os.Exit(1)
+	os.Stdout = stdout
+
+	fmt.Fprintln(os.Stderr, "")
 }
 
 func (c terminalUI) ConfigFailure(err error) {
-	c.Failed("Error loading config. Please reset the api '%s' and log in '%s'.\n%s",
+	c.Failed(i18n.T("terminal.error_loading_config"),
 		CommandColor(fmt.Sprintf("%s api", cf.Name)),
 		CommandColor(fmt.Sprintf("%s login", cf.Name)),
 		err.Error())
 }
 
 func (ui terminalUI) ShowConfiguration(config *configuration.Configuration) {
-	ui.Say("API endpoint: %s (API version: %s)",
+	ui.Say(i18n.T("terminal.api_endpoint"),
 		EntityNameColor(config.Target),
 		EntityNameColor(config.ApiVersion))
 
 	if !config.IsLoggedIn() {
-		ui.Say("Logged out, use '%s' to login", CommandColor(cf.Name+" login USERNAME"))
+		ui.Say(i18n.T("terminal.logged_out"), CommandColor(cf.Name+" login USERNAME"))
+	} else if config.IsClientLogin() {
+		ui.Say(i18n.T("terminal.client"), EntityNameColor(config.ClientId))
 	} else {
-		ui.Say("User:         %s", EntityNameColor(config.UserEmail()))
+		ui.Say(i18n.T("terminal.user"), EntityNameColor(config.UserEmail()))
 	}
 
 	if config.HasOrganization() {
-		ui.Say("Org:          %s", EntityNameColor(config.Organization.Name))
+		ui.Say(i18n.T("terminal.org"), EntityNameColor(config.Organization.Name))
 	}
 
 	if config.HasSpace() {
-		ui.Say("Space:        %s", EntityNameColor(config.Space.Name))
+		ui.Say(i18n.T("terminal.space"), EntityNameColor(config.Space.Name))
 	}
 }
 
 func (c terminalUI) LoadingIndication() {
-	fmt.Print(".")
+	if IsQuiet() {
+		return
+	}
+
+	fmt.Fprint(os.Stderr, ".")
 }
 
 func (c terminalUI) Wait(duration time.Duration) {
 	time.Sleep(duration)
 }
 
-func (ui terminalUI) DisplayTable(table [][]string) {
-
-	columnCount := len(table[0])
-	maxSizes := make([]int, columnCount)
-
-	for _, line := range table {
-		for index, value := range line {
-			cellLength := len(decolorize(value))
-			if maxSizes[index] < cellLength {
-				maxSizes[index] = cellLength
-			}
-		}
+// Writes data to stdout in the selected machine-readable format. Commands call
+// this alongside their usual output, so it does nothing for text output.
+// Fails when the data cannot be encoded.
+func (ui terminalUI) Render(data interface{}) (err error) {
+	if !IsMachineReadableOutput() {
+		return
 	}
 
-	for row, line := range table {
-		for col, value := range line {
-			padding := strings.Repeat(" ", maxSizes[col]-len(decolorize(value)))
-			value = tableColoringFunc(value, row, col)
-			fmt.Printf("%s%s   ", value, padding)
-		}
-		fmt.Print("\n")
+	renderer, err := NewRenderer(outputFormat)
+	if err == nil {
+		err = renderer.Render(os.Stdout, data)
 	}
+	if err != nil {
+		message := fmt.Sprintf(i18n.T("terminal.error_rendering_output"), outputFormat, err.Error())
+		ui.Failed("%s", message)
+		err = cf.NewCommandError(cf.GeneralError, "%s", message)
+	}
+	return
 }
 
 func tableColoringFunc(value string, row int, col int) string {
@@ -2,6 +2,7 @@ package terminal
 
 import (
 	"bytes"
+	"cf/i18n"
 	"github.com/stretchr/testify/assert"
 	"io"
 	"os"
@@ -31,7 +32,7 @@ func TestConfirmYes(t *testing.T) {
 		ui := new(terminalUI)
 
 		var result bool
-		out := captureOutput(func() {
+		out := captureErrors(func() {
 			result = ui.Confirm("Hello %s", "World?")
 		})
 
@@ -45,7 +46,7 @@ func TestConfirmNo(t *testing.T) {
 		ui := new(terminalUI)
 
 		var result bool
-		out := captureOutput(func() {
+		out := captureErrors(func() {
 			result = ui.Confirm("Hello %s", "World?")
 		})
 
@@ -54,6 +55,66 @@ func TestConfirmNo(t *testing.T) {
 	})
 }
 
+func TestConfirmAcceptsTranslatedAnswers(t *testing.T) {
+	i18n.SetLanguage("fr")
+	defer i18n.SetLanguage(i18n.DefaultLanguage)
+
+	for _, answer := range []string{"oui\n", "YES\n"} {
+		simulateStdin(answer, func() {
+			ui := new(terminalUI)
+
+			var result bool
+			captureErrors(func() {
+				result = ui.Confirm("Hello %s", "World?")
+			})
+
+			assert.True(t, result)
+		})
+	}
+}
+
+func TestStatusMessagesGoToStderr(t *testing.T) {
+	ui := new(terminalUI)
+
+	var out string
+	errOut := captureErrors(func() {
+		out = captureOutput(func() {
+			ui.Status("Getting apps...")
+			ui.Ok()
+			ui.Say("my-app")
+			ui.Warn("Careful")
+		})
+	})
+
+	assert.Equal(t, out, "my-app\n")
+	assert.Contains(t, errOut, "Getting apps...\n")
+	assert.Contains(t, errOut, "OK")
+	assert.Contains(t, errOut, "Careful")
+}
+
+func TestQuietHidesStatusMessages(t *testing.T) {
+	SetQuiet(true)
+	defer SetQuiet(false)
+	ui := new(terminalUI)
+
+	var out string
+	errOut := captureErrors(func() {
+		out = captureOutput(func() {
+			ui.Status("Getting apps...")
+			ui.Ok()
+			ui.LoadingIndication()
+			ui.Say("my-app")
+			ui.Warn("Careful")
+		})
+	})
+
+	assert.Equal(t, out, "my-app\n")
+	assert.NotContains(t, errOut, "Getting apps")
+	assert.NotContains(t, errOut, "OK")
+	assert.NotContains(t, errOut, ".")
+	assert.Contains(t, errOut, "Careful")
+}
+
 func simulateStdin(input string, block func()) {
 	defer func() {
 		stdin = os.Stdin
@@ -71,9 +132,17 @@ func simulateStdin(input string, block func()) {
 }
 
 func captureOutput(f func()) string {
-	old := os.Stdout // keep backup of the real stdout
+	return captureStream(&os.Stdout, f)
+}
+
+func captureErrors(f func()) string {
+	return captureStream(&os.Stderr, f)
+}
+
+func captureStream(stream **os.File, f func()) string {
+	old := *stream // keep backup of the real stream
 	r, w, _ := os.Pipe()
-	os.Stdout = w
+	*stream = w
 
 	f()
 
@@ -87,6 +156,6 @@ func captureOutput(f func()) string {
 
 	// back to normal state
 	w.Close()
-	os.Stdout = old // restoring the real stdout
+	*stream = old // restoring the real stream
 	return <-outC
 }
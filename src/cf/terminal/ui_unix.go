@@ -11,6 +11,7 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	"unsafe"
 )
 
 const (
@@ -26,12 +27,25 @@ var sttyArgvEOn []string = []string{"stty", "echo"}
 
 var ws syscall.WaitStatus = 0
 
+type windowSize struct {
+	rows, columns, xPixels, yPixels uint16
+}
+
+func stdoutTerminalWidth() int {
+	size := windowSize{}
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, os.Stdout.Fd(), uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&size)))
+	if errno != 0 {
+		return 0
+	}
+	return int(size.columns)
+}
+
 func (ui terminalUI) AskForPassword(prompt string, args ...interface{}) (passwd string) {
 	sig := make(chan os.Signal, 10)
 
 	// Display the prompt.
-	fmt.Println("")
-	fmt.Printf(prompt+" ", args...)
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintf(os.Stderr, prompt+" ", args...)
 
 	// File descriptors for stdin, stdout, and stderr.
 	fd := []uintptr{os.Stdin.Fd(), os.Stdout.Fd(), os.Stderr.Fd()}
@@ -53,7 +67,7 @@ func (ui terminalUI) AskForPassword(prompt string, args ...interface{}) (passwd
 	passwd = readPassword(pid)
 
 	// Carraige return after the user input.
-	fmt.Println("")
+	fmt.Fprintln(os.Stderr, "")
 
 	return
 }
@@ -3,8 +3,10 @@
 package terminal
 
 import (
+	"fmt"
 	"os"
 	"syscall"
+	"unsafe"
 )
 
 // see SetConsoleMode documentation for bit flags
@@ -24,7 +26,7 @@ func (ui terminalUI) AskForPassword(prompt string, args ...interface{}) (passwd
 
 	err = setConsoleMode(hStdin, newMode)
 	defer setConsoleMode(hStdin, originalMode)
-	defer ui.Say("")
+	defer fmt.Fprintln(os.Stderr, "")
 
 	if err != nil {
 		return
@@ -33,6 +35,26 @@ func (ui terminalUI) AskForPassword(prompt string, args ...interface{}) (passwd
 	return ui.Ask(prompt, args...)
 }
 
+type consoleScreenBufferInfo struct {
+	sizeX, sizeY                   int16
+	cursorX, cursorY               int16
+	attributes                     uint16
+	left, top, right, bottom       int16
+	maximumWindowX, maximumWindowY int16
+}
+
+func stdoutTerminalWidth() int {
+	dll := syscall.MustLoadDLL("kernel32")
+	proc := dll.MustFindProc("GetConsoleScreenBufferInfo")
+
+	info := consoleScreenBufferInfo{}
+	r, _, _ := proc.Call(os.Stdout.Fd(), uintptr(unsafe.Pointer(&info)))
+	if r == 0 {
+		return 0
+	}
+	return int(info.right-info.left) + 1
+}
+
 func setConsoleMode(console syscall.Handle, mode uint32) (err error) {
 	dll := syscall.MustLoadDLL("kernel32")
 	proc := dll.MustFindProc("SetConsoleMode")
@@ -10,15 +10,18 @@ import (
 	"fmt"
 	"cf/terminal"
 	"cf/configuration"
+	"cf/i18n"
 	"github.com/codegangsta/cli"
 	"cf/net"
 )
 
 func main() {
 	termUI := terminal.NewUI()
-	assignTemplates()
+	i18n.SetLanguage(i18n.DetectLanguage(""))
 	configRepo := configuration.NewConfigurationDiskRepository()
 	config := loadConfig(termUI, configRepo)
+	i18n.SetLanguage(i18n.DetectLanguage(config.Language))
+	assignTemplates()
 
 	repoLocator := api.NewRepositoryLocator(config, configRepo, map[string]net.Gateway{
 		"auth": net.NewUAAGateway(),
@@ -28,57 +31,41 @@ func main() {
 
 	cmdFactory := commands.NewFactory(termUI, config, configRepo, repoLocator)
 	reqFactory := requirements.NewFactory(termUI, config, repoLocator)
-	cmdRunner := commands.NewRunner(cmdFactory, reqFactory)
+	cmdRunner := commands.NewRunner(cmdFactory, reqFactory, termUI)
 
-	app, err := app.NewApp(cmdRunner)
+	cfApp, err := app.NewApp(cmdRunner)
 	if err != nil {
 		return
 	}
-	app.Run(os.Args)
+
+	err = cfApp.Run(app.ResolveAliases(cfApp, config, os.Args))
+	if err != nil {
+		os.Exit(commands.ExitCodeUsage)
+	}
+	os.Exit(cmdRunner.ExitCode())
 }
 
+// The help templates are translated, so they are assigned once the language
+// is known.
 func assignTemplates() {
-	cli.AppHelpTemplate = `NAME:
-   {{.Name}} - {{.Usage}}
-
-USAGE:
-   [environment variables] {{.Name}} [global options] command [arguments...] [command options]
-
-VERSION:
-   {{.Version}}
-
-COMMANDS:
-   {{range .Commands}}{{.Name}}{{with .ShortName}}, {{.}}{{end}}{{ "\t" }}{{.Description}}
-   {{end}}
-GLOBAL OPTIONS:
-   {{range .Flags}}{{.}}
-   {{end}}
-ENVIRONMENT VARIABLES:
-   CF_TRACE=true - will output HTTP requests and responses during command
-   HTTP_PROXY=http://proxy.example.com:8080 - set to your proxy
-`
-
-	cli.CommandHelpTemplate = `NAME:
-   {{.Name}} - {{.Description}}
-{{with .ShortName}}
-ALIAS:
-   {{.}}
-{{end}}
-USAGE:
-   {{.Usage}}{{with .Flags}}
-
-OPTIONS:
-   {{range .}}{{.}}
-   {{end}}{{else}}
-{{end}}`
-
+	cli.AppHelpTemplate = i18n.T("main.app_help_template")
+	cli.CommandHelpTemplate = i18n.T("main.command_help_template")
 }
 
 func loadConfig(termUI terminal.UI, configRepo configuration.ConfigurationRepository) (config *configuration.Configuration) {
 	config, err := configRepo.Get()
+	switch err.(type) {
+	case configuration.UnknownProfileError:
+		// The runner reports it, so that 'cf profile' can still fix it
+		return
+	case configuration.UnsupportedConfigVersionError:
+		termUI.Failed(err.Error())
+		os.Exit(1)
+		return
+	}
 	if err != nil {
 		termUI.Failed(fmt.Sprintf(
-			"Error loading config. Please reset target (%s) and log in (%s).",
+			i18n.T("main.error_loading_config"),
 			terminal.CommandColor(fmt.Sprintf("%s target", cf.Name)),
 			terminal.CommandColor(fmt.Sprintf("%s login", cf.Name)),
 		))
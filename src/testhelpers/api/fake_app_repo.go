@@ -23,11 +23,12 @@ type FakeApplicationRepository struct {
 
 	FindAllApps []cf.Application
 
-	FindByNameName      string
-	FindByNameApp       cf.Application
-	FindByNameErr       bool
-	FindByNameAuthErr   bool
-	FindByNameNotFound  bool
+	FindByNameName           string
+	FindByNameApp            cf.Application
+	FindByNameErr            bool
+	FindByNameAuthErr        bool
+	FindByNameSessionExpired bool
+	FindByNameNotFound       bool
 
 	SetEnvApp   cf.Application
 	SetEnvVars  map[string]string
@@ -53,6 +54,9 @@ func (repo *FakeApplicationRepository) FindByName(name string) (app cf.Applicati
 	if repo.FindByNameAuthErr {
 		apiResponse = net.NewApiResponse("Authentication failed.", "1000", 401)
 	}
+	if repo.FindByNameSessionExpired {
+		apiResponse = net.NewSessionExpiredApiResponse("Your session has expired.")
+	}
 	if repo.FindByNameNotFound {
 		apiResponse = net.NewNotFoundApiResponse("%s %s not found","App", name)
 	}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"cf"
 	"cf/configuration"
 	"cf/net"
 	testconfig "testhelpers/configuration"
@@ -10,18 +11,27 @@ type FakeAuthenticationRepository struct {
 	ConfigRepo testconfig.FakeConfigRepository
 
 	Config *configuration.Configuration
+	Credentials map[string]string
 	Email string
 	Password string
+	ClientId string
+	ClientSecret string
+	Passcode string
+	Prompts map[string]cf.AuthPrompt
+	GetLoginPromptsError bool
 
 	AuthError bool
+	RefreshAuthTokenCalled bool
+	RefreshAuthTokenError bool
 	AccessToken string
 	RefreshToken string
 }
 
-func (auth *FakeAuthenticationRepository) Authenticate(email string, password string) (apiResponse net.ApiResponse) {
+func (auth *FakeAuthenticationRepository) Authenticate(credentials map[string]string) (apiResponse net.ApiResponse) {
 	auth.Config, _ = auth.ConfigRepo.Get()
-	auth.Email = email
-	auth.Password = password
+	auth.Credentials = credentials
+	auth.Email = credentials["username"]
+	auth.Password = credentials["password"]
 
 	if auth.AccessToken == "" {
 		auth.AccessToken = "BEARER some_access_token"
@@ -37,6 +47,68 @@ func (auth *FakeAuthenticationRepository) Authenticate(email string, password st
 	return
 }
 
+func (auth *FakeAuthenticationRepository) AuthenticateClient(clientId string, clientSecret string) (apiResponse net.ApiResponse) {
+	auth.Config, _ = auth.ConfigRepo.Get()
+	auth.ClientId = clientId
+	auth.ClientSecret = clientSecret
+
+	if auth.AccessToken == "" {
+		auth.AccessToken = "BEARER some_access_token"
+	}
+
+	auth.Config.AccessToken = auth.AccessToken
+	auth.Config.ClientId = clientId
+	auth.Config.ClientSecret = clientSecret
+	auth.ConfigRepo.Save()
+
+	if auth.AuthError {
+		apiResponse =  net.NewApiResponseWithMessage("Error authenticating.")
+	}
+	return
+}
+
+func (auth *FakeAuthenticationRepository) AuthenticateWithPasscode(passcode string) (apiResponse net.ApiResponse) {
+	auth.Config, _ = auth.ConfigRepo.Get()
+	auth.Passcode = passcode
+
+	if auth.AccessToken == "" {
+		auth.AccessToken = "BEARER some_access_token"
+	}
+
+	auth.Config.AccessToken = auth.AccessToken
+	auth.Config.RefreshToken = auth.RefreshToken
+	auth.ConfigRepo.Save()
+
+	if auth.AuthError {
+		apiResponse =  net.NewApiResponseWithMessage("Error authenticating.")
+	}
+	return
+}
+
+func (auth *FakeAuthenticationRepository) GetLoginPrompts() (prompts map[string]cf.AuthPrompt, apiResponse net.ApiResponse) {
+	if auth.GetLoginPromptsError {
+		apiResponse = net.NewApiResponseWithMessage("Error fetching login prompts.")
+		return
+	}
+
+	prompts = auth.Prompts
+	if prompts == nil {
+		prompts = map[string]cf.AuthPrompt{
+			"username": cf.AuthPrompt{Type: cf.AuthPromptTypeText, DisplayName: "Username"},
+			"password": cf.AuthPrompt{Type: cf.AuthPromptTypePassword, DisplayName: "Password"},
+		}
+	}
+	return
+}
+
 func (auth *FakeAuthenticationRepository) RefreshAuthToken() (updatedToken string, apiResponse net.ApiResponse) {
+	auth.RefreshAuthTokenCalled = true
+
+	if auth.RefreshAuthTokenError {
+		apiResponse = net.NewSessionExpiredApiResponse("Your session has expired.")
+		return
+	}
+
+	updatedToken = auth.AccessToken
 	return
 }
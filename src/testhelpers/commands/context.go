@@ -7,10 +7,11 @@ import (
 	"strings"
 	"cf/commands"
 	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
 )
 
 func NewContext(cmdName string, args []string) (*cli.Context) {
-	targetCommand := findCommand(cmdName)
+	cfApp, targetCommand := findCommand(cmdName)
 
 	flagSet := new(flag.FlagSet)
 	for i, _ := range targetCommand.Flags {
@@ -35,20 +36,21 @@ func NewContext(cmdName string, args []string) (*cli.Context) {
 
 	globalSet := new(flag.FlagSet)
 
-	return cli.NewContext(cli.NewApp(), flagSet, globalSet)
+	return cli.NewContext(cfApp, flagSet, globalSet)
 }
 
-func findCommand(cmdName string) (cmd cli.Command) {
+func findCommand(cmdName string) (myApp *cli.App, cmd cli.Command) {
 	cmdFactory := commands.ConcreteFactory{}
 	reqFactory := &testreq.FakeReqFactory{}
-	cmdRunner := commands.NewRunner(cmdFactory, reqFactory)
-	myApp, _ := app.NewApp(cmdRunner)
+	cmdRunner := commands.NewRunner(cmdFactory, reqFactory, &testterm.FakeUI{})
+	myApp, _ = app.NewApp(cmdRunner)
 
-	for _, cmd := range myApp.Commands {
+	for _, cmd = range myApp.Commands {
 		if cmd.Name == cmdName {
-			return cmd
+			return
 		}
 	}
+	cmd = cli.Command{}
 
 	return
 }
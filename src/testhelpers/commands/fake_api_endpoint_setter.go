@@ -4,7 +4,7 @@ type FakeApiEndpointSetter struct {
 	SetEndpoint string
 }
 
-func (setter *FakeApiEndpointSetter) SetApiEndpoint(endpoint string) {
+func (setter *FakeApiEndpointSetter) SetApiEndpoint(endpoint string) (err error) {
 	setter.SetEndpoint = endpoint
 	return
 }
@@ -8,7 +8,7 @@ type FakeAppRestarter struct {
 	AppToRestart cf.Application
 }
 
-func (restarter *FakeAppRestarter) ApplicationRestart(appToRestart cf.Application) {
+func (restarter *FakeAppRestarter) ApplicationRestart(appToRestart cf.Application) (err error) {
 	restarter.AppToRestart = appToRestart
 	return
 }
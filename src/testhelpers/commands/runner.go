@@ -8,7 +8,7 @@ import (
 
 var CommandDidPassRequirements bool
 
-func RunCommand(cmd commands.Command, ctxt *cli.Context, reqFactory *testreq.FakeReqFactory) {
+func RunCommand(cmd commands.Command, ctxt *cli.Context, reqFactory *testreq.FakeReqFactory) (err error) {
 	CommandDidPassRequirements = false
 
 	reqs, err := cmd.GetRequirements(reqFactory, ctxt)
@@ -17,14 +17,14 @@ func RunCommand(cmd commands.Command, ctxt *cli.Context, reqFactory *testreq.Fak
 	}
 
 	for _, req := range reqs {
-		success := req.Execute()
-		if !success {
+		err = req.Execute()
+		if err != nil {
 			return
 		}
 	}
 
-	cmd.Run(ctxt)
 	CommandDidPassRequirements = true
+	err = cmd.Run(ctxt)
 
 	return
 }
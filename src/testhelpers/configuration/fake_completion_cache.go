@@ -0,0 +1,24 @@
+package configuration
+
+type FakeCompletionCache struct {
+	NamesByKind map[string][]string
+	ScopesByKind map[string]string
+}
+
+func (cache *FakeCompletionCache) Names(kind, scope string) (names []string, found bool) {
+	names, found = cache.NamesByKind[kind]
+	if found && cache.ScopesByKind[kind] != scope {
+		return nil, false
+	}
+	return
+}
+
+func (cache *FakeCompletionCache) Save(kind, scope string, names []string) (err error) {
+	if cache.NamesByKind == nil {
+		cache.NamesByKind = map[string][]string{}
+		cache.ScopesByKind = map[string]string{}
+	}
+	cache.NamesByKind[kind] = names
+	cache.ScopesByKind[kind] = scope
+	return
+}
@@ -36,6 +36,8 @@ func (repo FakeConfigRepository) Save() (err error) {
 func (repo FakeConfigRepository) ClearSession() (err error) {
 	c, _ := repo.Get()
 	c.AccessToken = ""
+	c.ClientId = ""
+	c.ClientSecret = ""
 	c.Organization = cf.Organization{}
 	c.Space = cf.Space{}
 
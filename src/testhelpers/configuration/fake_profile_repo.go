@@ -0,0 +1,64 @@
+package configuration
+
+import (
+	"fmt"
+)
+
+type FakeProfileRepository struct {
+	CurrentName string
+	Names       []string
+
+	CreatedName string
+	UsedName    string
+	DeletedName string
+}
+
+func (repo *FakeProfileRepository) Current() (name string) {
+	return repo.CurrentName
+}
+
+func (repo *FakeProfileRepository) List() (names []string, err error) {
+	names = repo.Names
+	return
+}
+
+func (repo *FakeProfileRepository) Create(name string) (err error) {
+	if repo.exists(name) {
+		err = fmt.Errorf("Profile %s already exists", name)
+		return
+	}
+
+	repo.CreatedName = name
+	repo.Names = append(repo.Names, name)
+	return
+}
+
+func (repo *FakeProfileRepository) Use(name string) (err error) {
+	if !repo.exists(name) {
+		err = fmt.Errorf("Profile %s does not exist", name)
+		return
+	}
+
+	repo.UsedName = name
+	repo.CurrentName = name
+	return
+}
+
+func (repo *FakeProfileRepository) Delete(name string) (err error) {
+	if !repo.exists(name) {
+		err = fmt.Errorf("Profile %s does not exist", name)
+		return
+	}
+
+	repo.DeletedName = name
+	return
+}
+
+func (repo *FakeProfileRepository) exists(name string) bool {
+	for _, existingName := range repo.Names {
+		if existingName == name {
+			return true
+		}
+	}
+	return false
+}
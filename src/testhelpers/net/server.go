@@ -11,6 +11,7 @@ import (
 type TestRequest struct {
 	Method   string
 	Path     string
+	Header   http.Header
 	Matcher  RequestMatcher
 	Response TestResponse
 }
@@ -63,9 +64,17 @@ func (h *TestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Header.Get("accept") != "application/json" {
 		h.logError("Accept header did not match.\nExpected: application/json\nActual:   ",r.Header.Get("accept"))
 	}
-	if !strings.HasPrefix(r.Header.Get("authorization"), "BEARER my_access_token") {
+	if _, ok := tester.Header["Authorization"]; !ok && !strings.HasPrefix(r.Header.Get("authorization"), "BEARER my_access_token") {
 		h.logError("Authorization header did not match.\nExpected: BEARER my_access_token\nActual:   ",r.Header.Get("authorization"))
 	}
+	for name, values := range tester.Header {
+		if (len(values) < 1) {
+			continue
+		}
+		if r.Header.Get(name) != values[0] {
+			h.logError("%s header did not match.\nExpected: %s\nActual:   %s",name,values[0],r.Header.Get(name))
+		}
+	}
 
 	// match custom request matcher
 	if tester.Matcher != nil && !tester.Matcher(r){
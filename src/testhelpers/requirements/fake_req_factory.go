@@ -3,6 +3,7 @@ package requirements
 import (
 	"cf/requirements"
 	"cf"
+	"errors"
 )
 
 type FakeReqFactory struct {
@@ -48,6 +49,10 @@ func (f *FakeReqFactory) NewLoginRequirement() requirements.Requirement {
 	return FakeRequirement{ f, f.LoginSuccess }
 }
 
+func (f *FakeReqFactory) NewAdminScopeRequirement() requirements.Requirement {
+	return FakeRequirement{ f, true }
+}
+
 func (f *FakeReqFactory) NewValidAccessTokenRequirement() requirements.Requirement {
 	return FakeRequirement{ f, f.ValidAccessTokenSuccess }
 }
@@ -93,8 +98,11 @@ type FakeRequirement struct {
 	success bool
 }
 
-func (r FakeRequirement) Execute() (success bool) {
-	return r.success
+func (r FakeRequirement) Execute() (err error) {
+	if !r.success {
+		err = errors.New("Requirement failed")
+	}
+	return
 }
 
 func (r FakeRequirement) GetApplication() cf.Application {
@@ -15,6 +15,9 @@ type FakeUI struct {
 	PasswordPrompts []string
 	Inputs  []string
 	FailedWithUsage bool
+	NonInteractive bool
+	Rendered []interface{}
+	RenderError error
 }
 
 func (ui *FakeUI) Say(message string, args ...interface{}) {
@@ -23,6 +26,10 @@ func (ui *FakeUI) Say(message string, args ...interface{}) {
 	return
 }
 
+func (ui *FakeUI) Status(message string, args ...interface{}) {
+	ui.Say(message, args...)
+}
+
 func (ui *FakeUI) Warn(message string, args ...interface{}) {
 	ui.Say(message,args...)
 	return
@@ -51,6 +58,10 @@ func (ui *FakeUI) AskForPassword(prompt string, args ...interface{}) (answer str
 	return
 }
 
+func (ui *FakeUI) IsInteractive() bool {
+	return !ui.NonInteractive
+}
+
 func (ui *FakeUI) Ok() {
 	ui.Say("OK")
 }
@@ -111,7 +122,12 @@ func (ui *FakeUI) showBaseConfig(config *configuration.Configuration) {
 
 }
 
-func (ui *FakeUI) DisplayTable(table [][]string) {
+func (ui *FakeUI) Render(data interface{}) (err error) {
+	ui.Rendered = append(ui.Rendered, data)
+	return ui.RenderError
+}
+
+func (ui *FakeUI) DisplayTable(table [][]string) (err error) {
 
 	for _, line := range table {
 		output := ""
@@ -120,4 +136,5 @@ func (ui *FakeUI) DisplayTable(table [][]string) {
 		}
 		ui.Say("%s",output)
 	}
+	return
 }